@@ -0,0 +1,316 @@
+// Package additives recognizes food additives in ingredient statements, by
+// E-number (e.g. "E621") or by common name (e.g. "carrageenan"), using a
+// bundled reference table.
+package additives
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Function classes.
+const (
+	Colour           = "colour"
+	Preservative     = "preservative"
+	Antioxidant      = "antioxidant"
+	AcidityRegulator = "acidity regulator"
+	Emulsifier       = "emulsifier"
+	Thickener        = "thickener"
+	Stabiliser       = "stabiliser"
+	AntiCakingAgent  = "anti-caking agent"
+	FlavourEnhancer  = "flavour enhancer"
+	GlazingAgent     = "glazing agent"
+	Sweetener        = "sweetener"
+	RaisingAgent     = "raising agent"
+	Humectant        = "humectant"
+)
+
+// Additive is a reference table entry.
+type Additive struct {
+	// Code is the additive's E-number, e.g. "E621".
+	Code string
+	// Name is the additive's common name.
+	Name string
+	// Class is the additive's main technological function.
+	Class string
+	// RiskNotes summarizes health concerns raised about the additive, if any.
+	RiskNotes string
+	// Names are other names the additive appears under on labels, matched as
+	// whole words ignoring case. Name is always matched.
+	Names []string
+}
+
+var table = []Additive{
+	{Code: "E100", Name: "Curcumin", Class: Colour, Names: []string{"turmeric extract"}},
+	{Code: "E101", Name: "Riboflavin", Class: Colour},
+	{Code: "E102", Name: "Tartrazine", Class: Colour,
+		RiskNotes: "Azo dye. In the EU, foods containing it must carry a warning that it may have an adverse effect on activity and attention in children. May cause reactions in people sensitive to aspirin.",
+		Names:     []string{"yellow 5", "fd&c yellow no. 5", "yellow no. 5"}},
+	{Code: "E104", Name: "Quinoline yellow", Class: Colour,
+		RiskNotes: "In the EU, foods containing it must carry a warning that it may have an adverse effect on activity and attention in children."},
+	{Code: "E110", Name: "Sunset yellow FCF", Class: Colour,
+		RiskNotes: "Azo dye. In the EU, foods containing it must carry a warning that it may have an adverse effect on activity and attention in children.",
+		Names:     []string{"sunset yellow", "yellow 6", "fd&c yellow no. 6", "yellow no. 6"}},
+	{Code: "E120", Name: "Carmine", Class: Colour,
+		RiskNotes: "Made from insects, so not vegan or vegetarian. Can cause allergic reactions.",
+		Names:     []string{"cochineal", "carminic acid"}},
+	{Code: "E122", Name: "Azorubine", Class: Colour,
+		RiskNotes: "Azo dye. In the EU, foods containing it must carry a warning that it may have an adverse effect on activity and attention in children.",
+		Names:     []string{"carmoisine"}},
+	{Code: "E124", Name: "Ponceau 4R", Class: Colour,
+		RiskNotes: "Azo dye. In the EU, foods containing it must carry a warning that it may have an adverse effect on activity and attention in children."},
+	{Code: "E129", Name: "Allura red AC", Class: Colour,
+		RiskNotes: "Azo dye. In the EU, foods containing it must carry a warning that it may have an adverse effect on activity and attention in children.",
+		Names:     []string{"allura red", "red 40", "fd&c red no. 40", "red no. 40"}},
+	{Code: "E133", Name: "Brilliant blue FCF", Class: Colour,
+		Names: []string{"brilliant blue", "blue 1", "fd&c blue no. 1", "blue no. 1"}},
+	{Code: "E150a", Name: "Plain caramel", Class: Colour},
+	{Code: "E150c", Name: "Ammonia caramel", Class: Colour,
+		RiskNotes: "May contain 4-methylimidazole, a by-product of its manufacture."},
+	{Code: "E150d", Name: "Sulphite ammonia caramel", Class: Colour,
+		RiskNotes: "May contain 4-methylimidazole, a by-product of its manufacture."},
+	{Code: "E160a", Name: "Carotenes", Class: Colour, Names: []string{"beta-carotene", "beta carotene"}},
+	{Code: "E160b", Name: "Annatto", Class: Colour, Names: []string{"bixin", "norbixin"}},
+	{Code: "E162", Name: "Beetroot red", Class: Colour, Names: []string{"betanin"}},
+	{Code: "E171", Name: "Titanium dioxide", Class: Colour,
+		RiskNotes: "Banned as a food additive in the EU since 2022, as genotoxicity concerns couldn't be ruled out."},
+	{Code: "E200", Name: "Sorbic acid", Class: Preservative},
+	{Code: "E202", Name: "Potassium sorbate", Class: Preservative},
+	{Code: "E210", Name: "Benzoic acid", Class: Preservative,
+		RiskNotes: "Can form benzene in drinks that also contain ascorbic acid. May cause reactions in people sensitive to aspirin."},
+	{Code: "E211", Name: "Sodium benzoate", Class: Preservative,
+		RiskNotes: "Can form benzene in drinks that also contain ascorbic acid. May cause reactions in people sensitive to aspirin."},
+	{Code: "E220", Name: "Sulphur dioxide", Class: Preservative,
+		RiskNotes: "Sulphites can trigger asthma and allergic reactions in sensitive people.",
+		Names:     []string{"sulfur dioxide"}},
+	{Code: "E223", Name: "Sodium metabisulphite", Class: Preservative,
+		RiskNotes: "Sulphites can trigger asthma and allergic reactions in sensitive people.",
+		Names:     []string{"sodium metabisulfite"}},
+	{Code: "E249", Name: "Potassium nitrite", Class: Preservative,
+		RiskNotes: "Can form carcinogenic nitrosamines, particularly when cured meats are cooked at high temperatures."},
+	{Code: "E250", Name: "Sodium nitrite", Class: Preservative,
+		RiskNotes: "Can form carcinogenic nitrosamines, particularly when cured meats are cooked at high temperatures."},
+	{Code: "E251", Name: "Sodium nitrate", Class: Preservative,
+		RiskNotes: "Converted to nitrite, which can form carcinogenic nitrosamines."},
+	{Code: "E252", Name: "Potassium nitrate", Class: Preservative,
+		RiskNotes: "Converted to nitrite, which can form carcinogenic nitrosamines.",
+		Names:     []string{"saltpetre", "saltpeter"}},
+	{Code: "E260", Name: "Acetic acid", Class: AcidityRegulator},
+	{Code: "E270", Name: "Lactic acid", Class: AcidityRegulator},
+	{Code: "E282", Name: "Calcium propionate", Class: Preservative},
+	{Code: "E290", Name: "Carbon dioxide", Class: Preservative},
+	{Code: "E296", Name: "Malic acid", Class: AcidityRegulator},
+	{Code: "E300", Name: "Ascorbic acid", Class: Antioxidant},
+	{Code: "E301", Name: "Sodium ascorbate", Class: Antioxidant},
+	{Code: "E306", Name: "Tocopherol-rich extract", Class: Antioxidant, Names: []string{"mixed tocopherols", "tocopherols"}},
+	{Code: "E319", Name: "Tertiary-butylhydroquinone", Class: Antioxidant, Names: []string{"tbhq"}},
+	{Code: "E320", Name: "Butylated hydroxyanisole", Class: Antioxidant,
+		RiskNotes: "Classified by IARC as possibly carcinogenic to humans (group 2B).",
+		Names:     []string{"bha"}},
+	{Code: "E321", Name: "Butylated hydroxytoluene", Class: Antioxidant, Names: []string{"bht"}},
+	{Code: "E322", Name: "Lecithins", Class: Emulsifier, Names: []string{"lecithin", "soy lecithin", "soya lecithin", "sunflower lecithin"}},
+	{Code: "E330", Name: "Citric acid", Class: AcidityRegulator},
+	{Code: "E331", Name: "Sodium citrates", Class: AcidityRegulator, Names: []string{"sodium citrate"}},
+	{Code: "E334", Name: "Tartaric acid", Class: AcidityRegulator},
+	{Code: "E338", Name: "Phosphoric acid", Class: AcidityRegulator,
+		RiskNotes: "High phosphate intake is associated with lower bone density and is a concern for people with kidney disease."},
+	{Code: "E339", Name: "Sodium phosphates", Class: AcidityRegulator,
+		RiskNotes: "High phosphate intake is a concern for people with kidney disease.",
+		Names:     []string{"sodium phosphate", "disodium phosphate"}},
+	{Code: "E341", Name: "Calcium phosphates", Class: AcidityRegulator, Names: []string{"calcium phosphate", "tricalcium phosphate"}},
+	{Code: "E400", Name: "Alginic acid", Class: Thickener},
+	{Code: "E401", Name: "Sodium alginate", Class: Thickener},
+	{Code: "E406", Name: "Agar", Class: Thickener, Names: []string{"agar-agar"}},
+	{Code: "E407", Name: "Carrageenan", Class: Thickener,
+		RiskNotes: "Degraded carrageenan (poligeenan) causes intestinal inflammation in animal studies. Not permitted in infant formula in the EU.",
+		Names:     []string{"carrageenans", "irish moss"}},
+	{Code: "E410", Name: "Locust bean gum", Class: Thickener, Names: []string{"carob bean gum"}},
+	{Code: "E412", Name: "Guar gum", Class: Thickener},
+	{Code: "E414", Name: "Gum arabic", Class: Stabiliser, Names: []string{"acacia gum"}},
+	{Code: "E415", Name: "Xanthan gum", Class: Thickener},
+	{Code: "E418", Name: "Gellan gum", Class: Thickener},
+	{Code: "E420", Name: "Sorbitol", Class: Sweetener, RiskNotes: "Can have a laxative effect in large amounts."},
+	{Code: "E422", Name: "Glycerol", Class: Humectant, Names: []string{"glycerin", "glycerine"}},
+	{Code: "E433", Name: "Polysorbate 80", Class: Emulsifier,
+		RiskNotes: "Altered gut microbiota and promoted inflammation in animal studies."},
+	{Code: "E440", Name: "Pectins", Class: Thickener, Names: []string{"pectin"}},
+	{Code: "E450", Name: "Diphosphates", Class: RaisingAgent,
+		RiskNotes: "High phosphate intake is a concern for people with kidney disease.",
+		Names:     []string{"sodium acid pyrophosphate", "disodium diphosphate"}},
+	{Code: "E460", Name: "Cellulose", Class: AntiCakingAgent, Names: []string{"microcrystalline cellulose", "powdered cellulose"}},
+	{Code: "E466", Name: "Carboxymethyl cellulose", Class: Thickener,
+		RiskNotes: "Altered gut microbiota and promoted inflammation in animal studies.",
+		Names:     []string{"cellulose gum", "sodium carboxymethyl cellulose"}},
+	{Code: "E471", Name: "Mono- and diglycerides of fatty acids", Class: Emulsifier,
+		Names: []string{"mono- and diglycerides", "mono and diglycerides", "monoglycerides", "diglycerides"}},
+	{Code: "E472e", Name: "DATEM", Class: Emulsifier,
+		Names: []string{"mono- and diacetyl tartaric acid esters of mono- and diglycerides of fatty acids"}},
+	{Code: "E476", Name: "Polyglycerol polyricinoleate", Class: Emulsifier, Names: []string{"pgpr"}},
+	{Code: "E481", Name: "Sodium stearoyl-2-lactylate", Class: Emulsifier, Names: []string{"sodium stearoyl lactylate"}},
+	{Code: "E500", Name: "Sodium carbonates", Class: RaisingAgent, Names: []string{"sodium bicarbonate", "baking soda", "bicarbonate of soda"}},
+	{Code: "E503", Name: "Ammonium carbonates", Class: RaisingAgent, Names: []string{"ammonium bicarbonate"}},
+	{Code: "E551", Name: "Silicon dioxide", Class: AntiCakingAgent, Names: []string{"silica"}},
+	{Code: "E621", Name: "Monosodium glutamate", Class: FlavourEnhancer,
+		RiskNotes: "Some people report short-term symptoms such as headache after large amounts, though studies haven't confirmed a consistent link.",
+		Names:     []string{"msg", "sodium glutamate"}},
+	{Code: "E627", Name: "Disodium guanylate", Class: FlavourEnhancer,
+		RiskNotes: "Not suitable for people with gout, as it's metabolized to purines."},
+	{Code: "E631", Name: "Disodium inosinate", Class: FlavourEnhancer,
+		RiskNotes: "Not suitable for people with gout, as it's metabolized to purines."},
+	{Code: "E635", Name: "Disodium 5'-ribonucleotides", Class: FlavourEnhancer,
+		RiskNotes: "Not suitable for people with gout, as it's metabolized to purines."},
+	{Code: "E901", Name: "Beeswax", Class: GlazingAgent, RiskNotes: "Animal-derived, so not vegan."},
+	{Code: "E903", Name: "Carnauba wax", Class: GlazingAgent},
+	{Code: "E904", Name: "Shellac", Class: GlazingAgent, RiskNotes: "Made from insects, so not vegan."},
+	{Code: "E950", Name: "Acesulfame K", Class: Sweetener, Names: []string{"acesulfame potassium", "acesulfame-k"}},
+	{Code: "E951", Name: "Aspartame", Class: Sweetener,
+		RiskNotes: "Contains a source of phenylalanine, so unsuitable for people with phenylketonuria. Classified by IARC as possibly carcinogenic to humans (group 2B)."},
+	{Code: "E952", Name: "Cyclamates", Class: Sweetener, RiskNotes: "Not permitted as a food additive in the US.",
+		Names: []string{"cyclamate", "sodium cyclamate"}},
+	{Code: "E954", Name: "Saccharin", Class: Sweetener},
+	{Code: "E955", Name: "Sucralose", Class: Sweetener},
+	{Code: "E960", Name: "Steviol glycosides", Class: Sweetener, Names: []string{"stevia", "stevia extract", "rebaudioside a"}},
+	{Code: "E965", Name: "Maltitol", Class: Sweetener, RiskNotes: "Can have a laxative effect in large amounts."},
+	{Code: "E967", Name: "Xylitol", Class: Sweetener,
+		RiskNotes: "Can have a laxative effect in large amounts. Highly toxic to dogs."},
+	{Code: "E968", Name: "Erythritol", Class: Sweetener},
+	{Code: "E1422", Name: "Acetylated distarch adipate", Class: Thickener},
+}
+
+var (
+	byCode = make(map[string]Additive)
+	// names matches any additive name.
+	names  *regexp.Regexp
+	byName = make(map[string]Additive)
+	// eNumber matches E-numbers and INS numbers, with an optional subclass
+	// letter (E150d) or Roman numeral (E322(i)).
+	eNumber = regexp.MustCompile(`(?i)\b(?:e|ins)[\s-]?(\d{3,4})([a-z]?)\b(\([ivx]+\))?`)
+)
+
+func init() {
+	var all []string
+	for _, a := range table {
+		byCode[strings.ToLower(a.Code)] = a
+		for _, n := range append([]string{a.Name}, a.Names...) {
+			n = strings.ToLower(n)
+			byName[foldKey(n)] = a
+			all = append(all, n)
+		}
+	}
+	names = wordsPattern(all)
+}
+
+// Lookup returns the additive with the given E-number, e.g. "E621" or
+// "e 150d". Roman numeral subclasses, as in "E322(i)", aren't in the table
+// and fall back to the main additive.
+func Lookup(code string) (Additive, bool) {
+	m := eNumber.FindStringSubmatch(code)
+	if m == nil {
+		return Additive{}, false
+	}
+	return lookup(m[1], m[2])
+}
+
+func lookup(number, subclass string) (Additive, bool) {
+	a, ok := byCode["e"+number+strings.ToLower(subclass)]
+	return a, ok
+}
+
+// lookupName returns the additive with the given name, ignoring case.
+func lookupName(name string) (Additive, bool) {
+	a, ok := byName[foldKey(name)]
+	return a, ok
+}
+
+// foldKey returns the same key for strings that are equal under Unicode case
+// folding, like strings.EqualFold. Lower-casing isn't enough, since some
+// characters, like the long s in "MſG", fold to a letter they don't
+// lower-case to.
+func foldKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		// Use the smallest rune in r's folding orbit.
+		min := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < min {
+				min = f
+			}
+		}
+		return min
+	}, s)
+}
+
+// wordsPattern matches any of the given words or phrases as whole words,
+// ignoring case.
+func wordsPattern(words []string) *regexp.Regexp {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	// Longer names first, so "soy lecithin" is preferred over "lecithin".
+	sort.SliceStable(quoted, func(i, j int) bool {
+		return len(quoted[i]) > len(quoted[j])
+	})
+	return regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// Match is an additive found in some text.
+type Match struct {
+	Additive
+	// Terms are the E-numbers and names that indicated the additive, as they
+	// appear in the text.
+	Terms []string
+}
+
+// Scan returns the additives mentioned in text, in order of their first
+// mention. An additive mentioned by both its E-number and its name, as in
+// "emulsifier (soy lecithin, E322)", is returned once.
+func Scan(text string) []Match {
+	type hit struct {
+		at   int
+		a    Additive
+		term string
+	}
+	var hits []hit
+	for _, loc := range eNumber.FindAllStringSubmatchIndex(text, -1) {
+		if a, ok := lookup(text[loc[2]:loc[3]], text[loc[4]:loc[5]]); ok {
+			hits = append(hits, hit{at: loc[0], a: a, term: strings.TrimSpace(text[loc[0]:loc[1]])})
+		}
+	}
+	// Names are matched in the original text rather than a lower-cased copy,
+	// whose byte offsets can differ for characters like "Ⱥ".
+	for _, loc := range names.FindAllStringSubmatchIndex(text, -1) {
+		term := text[loc[2]:loc[3]]
+		if a, ok := lookupName(term); ok {
+			hits = append(hits, hit{at: loc[2], a: a, term: term})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].at < hits[j].at
+	})
+
+	var out []Match
+	index := make(map[string]int)
+	for _, h := range hits {
+		i, ok := index[h.a.Code]
+		if !ok {
+			i = len(out)
+			index[h.a.Code] = i
+			out = append(out, Match{Additive: h.a})
+		}
+		if !contains(out[i].Terms, h.term) {
+			out[i].Terms = append(out[i].Terms, h.term)
+		}
+	}
+	return out
+}
+
+func contains(values []string, v string) bool {
+	for _, w := range values {
+		if strings.EqualFold(v, w) {
+			return true
+		}
+	}
+	return false
+}
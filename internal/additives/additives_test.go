@@ -0,0 +1,114 @@
+package additives
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected string
+	}{
+		"e-number":            {in: "E621", expected: "Monosodium glutamate"},
+		"lowercase and space": {in: "e 407", expected: "Carrageenan"},
+		"hyphen":              {in: "E-211", expected: "Sodium benzoate"},
+		"ins number":          {in: "INS 330", expected: "Citric acid"},
+		"subclass letter":     {in: "E150d", expected: "Sulphite ammonia caramel"},
+		"roman numeral":       {in: "E322(i)", expected: "Lecithins"},
+		"four digits":         {in: "E1422", expected: "Acetylated distarch adipate"},
+		"unknown subclass":    {in: "E150b", expected: ""},
+		"unknown":             {in: "E999", expected: ""},
+		"not an e-number":     {in: "carrageenan", expected: ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			a, ok := Lookup(tc.in)
+			require.Equal(t, tc.expected != "", ok)
+			require.Equal(t, tc.expected, a.Name)
+		})
+	}
+}
+
+func TestLookupName(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected string
+	}{
+		"lower case":      {in: "msg", expected: "E621"},
+		"upper case":      {in: "MSG", expected: "E621"},
+		"long s":          {in: "MſG", expected: "E621"},
+		"other name":      {in: "Sodium Benzoate", expected: "E211"},
+		"not an additive": {in: "salt", expected: ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			a, ok := lookupName(tc.in)
+			require.Equal(t, tc.expected != "", ok)
+			require.Equal(t, tc.expected, a.Code)
+		})
+	}
+}
+
+func TestNamesAreUnique(t *testing.T) {
+	seen := make(map[string]string)
+	for _, a := range table {
+		for _, n := range append([]string{a.Name}, a.Names...) {
+			key := foldKey(n)
+			if code, ok := seen[key]; ok {
+				require.Equal(t, code, a.Code, "%q names both %s and %s", n, code, a.Code)
+			}
+			seen[key] = a.Code
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	matches := Scan("Noodles (wheat flour, palm oil, salt, thickener (guar gum)), seasoning (salt, flavour " +
+		"enhancers (E621, disodium 5'-ribonucleotides), sugar, colour: e150d), emulsifier (soy lecithin, E322), " +
+		"Yellow 5, MSG.")
+
+	type result struct {
+		Code  string
+		Class string
+		Terms []string
+	}
+	var actual []result
+	for _, m := range matches {
+		actual = append(actual, result{Code: m.Code, Class: m.Class, Terms: m.Terms})
+	}
+	require.Equal(t, []result{
+		{Code: "E412", Class: Thickener, Terms: []string{"guar gum"}},
+		{Code: "E621", Class: FlavourEnhancer, Terms: []string{"E621", "MSG"}},
+		{Code: "E635", Class: FlavourEnhancer, Terms: []string{"disodium 5'-ribonucleotides"}},
+		{Code: "E150d", Class: Colour, Terms: []string{"e150d"}},
+		{Code: "E322", Class: Emulsifier, Terms: []string{"soy lecithin", "E322"}},
+		{Code: "E102", Class: Colour, Terms: []string{"Yellow 5"}},
+	}, actual)
+	require.NotEmpty(t, matches[len(matches)-1].RiskNotes)
+}
+
+func TestScanMultibyteText(t *testing.T) {
+	tests := map[string]struct {
+		in    string
+		code  string
+		terms []string
+	}{
+		"lower case grows":   {in: "ȺȺȺȺ msg", code: "E621", terms: []string{"msg"}},
+		"lower case shrinks": {in: "\u212A\u212A\u212A, sorbic acid", code: "E200", terms: []string{"sorbic acid"}},
+		"folded letter":      {in: "salt, MſG", code: "E621", terms: []string{"MſG"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches := Scan(tc.in)
+			require.Len(t, matches, 1)
+			require.Equal(t, tc.code, matches[0].Code)
+			require.Equal(t, tc.terms, matches[0].Terms)
+		})
+	}
+}
+
+func TestScanWholeWords(t *testing.T) {
+	require.Empty(t, Scan("agaric mushrooms, message, pectinate, size E12, phone 555-0100"))
+	require.Empty(t, Scan(""))
+}
@@ -0,0 +1,317 @@
+// Package allergens detects allergens in ingredient statements using a
+// dictionary of allergens, their synonyms and the ingredients derived from
+// them (e.g. whey from milk).
+package allergens
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Regulations that define lists of major allergens.
+const (
+	// FDA is the FDA's nine major food allergens.
+	FDA = "fda"
+	// EU is the fourteen allergens listed in Annex II of Regulation (EU) No
+	// 1169/2011.
+	EU = "eu"
+)
+
+// Allergen is a dictionary entry.
+type Allergen struct {
+	// Name is the canonical allergen name, e.g. "milk".
+	Name string `json:"name"`
+	// Regulations lists the regulations that name this a major allergen.
+	Regulations []string `json:"regulations"`
+	// Terms are the words that indicate the allergen, including synonyms and
+	// derived ingredients. They're matched as whole words, ignoring case.
+	Terms []string `json:"terms"`
+	// Exclude are phrases that contain a term but don't indicate the
+	// allergen, e.g. "cocoa butter" for milk.
+	Exclude []string `json:"exclude"`
+}
+
+// Dictionary is a compiled set of allergens.
+type Dictionary struct {
+	entries []entry
+}
+
+type entry struct {
+	Allergen
+	terms   *regexp.Regexp
+	exclude *regexp.Regexp
+}
+
+var builtin = []Allergen{
+	{
+		Name:        "milk",
+		Regulations: []string{FDA, EU},
+		Terms: []string{"milk", "milks", "whey", "casein", "caseinate", "caseinates", "lactose", "lactalbumin",
+			"lactoglobulin", "butter", "buttermilk", "cream", "cheese", "cheeses", "ghee", "yogurt", "yoghurt",
+			"curd", "curds", "dairy", "kefir", "custard"},
+		Exclude: []string{"cocoa butter", "shea butter", "peanut butter", "nut butter", "almond butter",
+			"apple butter", "cream of tartar", "coconut milk", "coconut cream", "almond milk", "soy milk",
+			"soya milk", "oat milk", "rice milk", "milk thistle", "non-dairy", "dairy-free",
+			"dairy free"},
+	},
+	{
+		Name:        "eggs",
+		Regulations: []string{FDA, EU},
+		Terms:       []string{"egg", "eggs", "albumin", "albumen", "ovalbumin", "lysozyme", "mayonnaise", "meringue"},
+		Exclude:     []string{"eggplant", "eggplants"},
+	},
+	{
+		Name:        "fish",
+		Regulations: []string{FDA, EU},
+		Terms: []string{"fish", "anchovy", "anchovies", "cod", "salmon", "tuna", "tilapia", "pollock", "haddock",
+			"sardine", "sardines", "trout", "mackerel", "herring", "halibut", "bass", "catfish"},
+	},
+	{
+		Name:        "crustaceans",
+		Regulations: []string{FDA, EU},
+		Terms: []string{"crustacean", "crustaceans", "shrimp", "shrimps", "prawn", "prawns", "crab", "crabs",
+			"lobster", "lobsters", "crayfish", "crawfish", "krill", "shellfish"},
+	},
+	{
+		Name:        "tree nuts",
+		Regulations: []string{FDA, EU},
+		Terms: []string{"tree nut", "tree nuts", "nut", "nuts", "almond", "almonds", "cashew", "cashews", "walnut",
+			"walnuts", "pecan", "pecans", "pistachio", "pistachios", "hazelnut", "hazelnuts", "filbert",
+			"filberts", "macadamia", "brazil nut", "brazil nuts", "pine nut", "pine nuts", "praline",
+			"marzipan", "gianduja"},
+		Exclude: []string{"nut-free", "nut free"},
+	},
+	{
+		Name:        "peanuts",
+		Regulations: []string{FDA, EU},
+		Terms:       []string{"peanut", "peanuts", "groundnut", "groundnuts", "arachis"},
+		Exclude:     []string{"peanut-free", "peanut free"},
+	},
+	{
+		Name:        "wheat",
+		Regulations: []string{FDA},
+		Terms: []string{"wheat", "spelt", "durum", "semolina", "farina", "kamut", "einkorn", "emmer", "bulgur",
+			"couscous", "seitan", "graham"},
+		Exclude: []string{"buckwheat", "wheat-free", "wheat free"},
+	},
+	{
+		Name:        "gluten",
+		Regulations: []string{EU},
+		Terms: []string{"gluten", "wheat", "spelt", "durum", "semolina", "farina", "kamut", "einkorn", "emmer",
+			"bulgur", "couscous", "seitan", "barley", "rye", "oat", "oats", "malt", "triticale"},
+		Exclude: []string{"buckwheat", "gluten-free", "gluten free"},
+	},
+	{
+		Name:        "soy",
+		Regulations: []string{FDA, EU},
+		Terms:       []string{"soy", "soya", "soybean", "soybeans", "edamame", "tofu", "miso", "tempeh", "natto"},
+	},
+	{
+		Name:        "sesame",
+		Regulations: []string{FDA, EU},
+		Terms:       []string{"sesame", "tahini", "benne", "gingelly"},
+	},
+	{
+		Name:        "celery",
+		Regulations: []string{EU},
+		Terms:       []string{"celery", "celeriac"},
+	},
+	{
+		Name:        "mustard",
+		Regulations: []string{EU},
+		Terms:       []string{"mustard"},
+	},
+	{
+		Name:        "sulphites",
+		Regulations: []string{EU},
+		Terms: []string{"sulphite", "sulphites", "sulfite", "sulfites", "sulfur dioxide", "sulphur dioxide",
+			"metabisulfite", "metabisulphite", "bisulfite", "bisulphite", "e220", "e221", "e222", "e223",
+			"e224", "e225", "e226", "e227", "e228"},
+	},
+	{
+		Name:        "lupin",
+		Regulations: []string{EU},
+		Terms:       []string{"lupin", "lupine", "lupins", "lupines"},
+	},
+	{
+		Name:        "molluscs",
+		Regulations: []string{EU},
+		Terms: []string{"mollusc", "molluscs", "mollusk", "mollusks", "clam", "clams", "mussel", "mussels",
+			"oyster", "oysters", "scallop", "scallops", "squid", "octopus", "snail", "snails", "calamari",
+			"abalone", "cuttlefish", "escargot"},
+	},
+}
+
+// Builtin returns the dictionary that ships with the proxy, covering the
+// FDA's and EU's major allergens.
+func Builtin() *Dictionary {
+	d, err := New(builtin)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// New compiles a dictionary.
+func New(allergens []Allergen) (*Dictionary, error) {
+	d := &Dictionary{}
+	for _, a := range allergens {
+		if a.Name == "" {
+			return nil, fmt.Errorf("allergen is missing a name")
+		}
+		if len(a.Terms) == 0 {
+			return nil, fmt.Errorf("allergen %q has no terms", a.Name)
+		}
+		e := entry{Allergen: a, terms: wordsPattern(a.Terms)}
+		if len(a.Exclude) > 0 {
+			e.exclude = wordsPattern(a.Exclude)
+		}
+		d.entries = append(d.entries, e)
+	}
+	return d, nil
+}
+
+// wordsPattern matches any of the given words or phrases as whole words.
+func wordsPattern(words []string) *regexp.Regexp {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(strings.ToLower(strings.TrimSpace(w)))
+	}
+	// Longer phrases first, so "brazil nuts" is preferred over "nuts".
+	sort.SliceStable(quoted, func(i, j int) bool {
+		return len(quoted[i]) > len(quoted[j])
+	})
+	return regexp.MustCompile(`\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// file is the layout of a custom dictionary file.
+type file struct {
+	Allergens []Allergen `json:"allergens"`
+}
+
+// Load returns the builtin dictionary extended with the entries in the JSON
+// file at path. Entries named like a builtin allergen add to its terms,
+// exclusions and regulations; others are added as new allergens. An empty
+// path returns the builtin dictionary.
+func Load(path string) (*Dictionary, error) {
+	if path == "" {
+		return Builtin(), nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allergen dictionary: %w", err)
+	}
+	var f file
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse allergen dictionary: %w", err)
+	}
+
+	merged := make([]Allergen, len(builtin))
+	copy(merged, builtin)
+	for _, custom := range f.Allergens {
+		found := false
+		for i, a := range merged {
+			if a.Name != custom.Name {
+				continue
+			}
+			found = true
+			merged[i] = Allergen{
+				Name:        a.Name,
+				Regulations: appendNew(a.Regulations, custom.Regulations),
+				Terms:       appendNew(a.Terms, custom.Terms),
+				Exclude:     appendNew(a.Exclude, custom.Exclude),
+			}
+		}
+		if !found {
+			merged = append(merged, custom)
+		}
+	}
+	return New(merged)
+}
+
+func appendNew(to, from []string) []string {
+	out := append([]string(nil), to...)
+	for _, v := range from {
+		exists := false
+		for _, w := range out {
+			if strings.EqualFold(v, w) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Match is an allergen found in some text.
+type Match struct {
+	Allergen    string
+	Regulations []string
+	// Terms are the terms that indicated the allergen, as they appear in
+	// the dictionary.
+	Terms []string
+}
+
+// Scan returns the allergens mentioned in text, in dictionary order.
+func (d *Dictionary) Scan(text string) []Match {
+	text = strings.ToLower(text)
+	var out []Match
+	for _, e := range d.entries {
+		t := text
+		if e.exclude != nil {
+			t = e.exclude.ReplaceAllString(t, " ")
+		}
+		found := e.terms.FindAllString(t, -1)
+		if len(found) == 0 {
+			continue
+		}
+		out = append(out, Match{
+			Allergen:    e.Name,
+			Regulations: e.Regulations,
+			Terms:       appendNew(nil, found),
+		})
+	}
+	return out
+}
+
+// Canonical returns the canonical names of the allergens a name refers to,
+// e.g. "tree nuts" for "Cashews". A name the dictionary doesn't recognize is
+// returned as is, in lower case.
+func (d *Dictionary) Canonical(name string) []string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil
+	}
+	var out []string
+	if d != nil {
+		for _, m := range d.Scan(name) {
+			out = append(out, m.Allergen)
+		}
+	}
+	if len(out) == 0 {
+		out = []string{name}
+	}
+	return out
+}
+
+// traceMarker matches the start of a precautionary allergen statement, like
+// "May contain" or "Made in a facility that also processes".
+var traceMarker = regexp.MustCompile(`(?i)\b(?:may (?:also )?contain|traces? of|(?:made|processed|produced|manufactured|packaged|packed) (?:in|on) (?:a )?(?:facility|plant|equipment|shared equipment|line))`)
+
+// SplitTraces splits an ingredient statement into the ingredients and any
+// trailing precautionary statement about traces.
+func SplitTraces(text string) (ingredients, traces string) {
+	loc := traceMarker.FindStringIndex(text)
+	if loc == nil {
+		return text, ""
+	}
+	return text[:loc[0]], text[loc[0]:]
+}
@@ -0,0 +1,108 @@
+package allergens
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func allergenNames(matches []Match) []string {
+	var out []string
+	for _, m := range matches {
+		out = append(out, m.Allergen)
+	}
+	return out
+}
+
+func TestScan(t *testing.T) {
+	d := Builtin()
+
+	tests := map[string]struct {
+		in       string
+		expected []string
+	}{
+		"derived ingredients":      {in: "Sugar, WHEY, sodium caseinate", expected: []string{"milk"}},
+		"excluded phrases":         {in: "sugar, cocoa butter, cream of tartar, coconut milk", expected: nil},
+		"excluded and real terms":  {in: "cocoa butter, butter", expected: []string{"milk"}},
+		"wheat counts as gluten":   {in: "enriched wheat flour", expected: []string{"wheat", "gluten"}},
+		"buckwheat is not wheat":   {in: "buckwheat flour", expected: nil},
+		"whole words only":         {in: "eggplant, nutmeg, coconut, butternut squash", expected: nil},
+		"several allergens":        {in: "peanuts, almonds, soy lecithin, sesame seeds", expected: []string{"tree nuts", "peanuts", "soy", "sesame"}},
+		"eu allergens":             {in: "celery, mustard seed, sulphur dioxide, lupin flour", expected: []string{"celery", "mustard", "sulphites", "lupin"}},
+		"shellfish and molluscs":   {in: "shrimp, squid", expected: []string{"crustaceans", "molluscs"}},
+		"fish but not shellfish":   {in: "anchovy paste", expected: []string{"fish"}},
+		"free-from claims ignored": {in: "gluten-free oats", expected: []string{"gluten"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, allergenNames(d.Scan(tc.in)))
+		})
+	}
+
+	matches := d.Scan("whey protein, milk powder, whey")
+	require.Equal(t, []Match{{Allergen: "milk", Regulations: []string{FDA, EU}, Terms: []string{"whey", "milk"}}}, matches)
+}
+
+func TestCanonical(t *testing.T) {
+	d := Builtin()
+
+	tests := map[string]struct {
+		in       string
+		expected []string
+	}{
+		"canonical name":    {in: "milk", expected: []string{"milk"}},
+		"synonym":           {in: " Cashews", expected: []string{"tree nuts"}},
+		"several allergens": {in: "Wheat", expected: []string{"wheat", "gluten"}},
+		"unrecognized":      {in: "Coconut", expected: []string{"coconut"}},
+		"empty":             {in: " ", expected: nil},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, d.Canonical(tc.in))
+		})
+	}
+
+	var nilDict *Dictionary
+	require.Equal(t, []string{"nuts"}, nilDict.Canonical("Nuts"))
+}
+
+func TestSplitTraces(t *testing.T) {
+	ingredients, traces := SplitTraces("Oats, honey. May contain tree nuts and milk.")
+	require.Equal(t, "Oats, honey. ", ingredients)
+	require.Equal(t, "May contain tree nuts and milk.", traces)
+
+	ingredients, traces = SplitTraces("Oats, honey. Made in a facility that also processes peanuts.")
+	require.Equal(t, "Oats, honey. ", ingredients)
+	require.Equal(t, "Made in a facility that also processes peanuts.", traces)
+
+	ingredients, traces = SplitTraces("Oats, honey")
+	require.Equal(t, "Oats, honey", ingredients)
+	require.Empty(t, traces)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allergens.json")
+	err := os.WriteFile(path, []byte(`{
+  "allergens": [
+    {"name": "milk", "terms": ["paneer"]},
+    {"name": "kiwi", "regulations": ["custom"], "terms": ["kiwi", "kiwifruit"]}
+  ]
+}`), 0o600)
+	require.NoError(t, err)
+
+	d, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"milk", "kiwi"}, allergenNames(d.Scan("paneer, kiwifruit")))
+	// Builtin terms still apply.
+	require.Equal(t, []string{"milk"}, allergenNames(d.Scan("whey")))
+	// The builtin dictionary isn't modified.
+	require.Empty(t, Builtin().Scan("paneer"))
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"allergens": [{"name": "kiwi"}]}`), 0o600))
+	_, err = Load(path)
+	require.Error(t, err)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
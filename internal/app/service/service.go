@@ -1,16 +1,79 @@
 package service
 
 import (
+	"context"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/allergens"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1/chompv1beta1connect"
 	"github.com/kevinmichaelchen/chomp-proxy/internal/service"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/config"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/metrics"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/tracing"
 	"go.uber.org/fx"
+	"net/http"
 )
 
 var Module = fx.Module("service",
 	fx.Provide(
+		NewConfig,
 		NewService,
+		fx.Annotate(
+			NewProbes,
+			fx.ResultTags(`group:"health_probes,flatten"`),
+		),
 	),
 )
 
-func NewService() *service.Service {
-	return service.NewService()
+type Config struct {
+	// DailyValueProfilesPath is an optional JSON file of custom reference
+	// intake profiles, in addition to the builtin "fda" and "eu_ri" profiles.
+	DailyValueProfilesPath string `env:"DAILY_VALUE_PROFILES_PATH"`
+
+	// AllergenDictionaryPath is an optional JSON file of allergens and terms
+	// that extends the builtin allergen dictionary.
+	AllergenDictionaryPath string `env:"ALLERGEN_DICTIONARY_PATH"`
+
+	// HealthCheckAPIKey is a server-owned Chomp API key used to probe Chomp's
+	// health. Without it, Chomp isn't probed, since clients bring their own
+	// keys. Each probe is a real barcode lookup billed to this key, made every
+	// GRPC_CONNECT_HEALTH_CHECK_INTERVAL but at most every 10s (2,880 lookups
+	// a day at the default 30s).
+	HealthCheckAPIKey string `env:"CHOMP_HEALTH_CHECK_API_KEY" secret:"true"`
+}
+
+func NewConfig(src *config.Source) (cfg Config, err error) {
+	err = src.Process(context.Background(), &cfg)
+	return
+}
+
+func NewService(cfg Config, m *metrics.Metrics, t *tracing.Tracing) (*service.Service, error) {
+	profiles, err := dailyvalue.Load(cfg.DailyValueProfilesPath)
+	if err != nil {
+		return nil, err
+	}
+	dictionary, err := allergens.Load(cfg.AllergenDictionaryPath)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Transport: t.RoundTripper("chomp", m.RoundTripper("chomp", http.DefaultTransport)),
+	}
+	return service.NewService(profiles, dictionary, client), nil
+}
+
+// NewProbes returns the health probes for the service's dependencies.
+func NewProbes(cfg Config, svc *service.Service) []modConnect.Probe {
+	if cfg.HealthCheckAPIKey == "" {
+		return nil
+	}
+	return []modConnect.Probe{
+		{
+			Name:     "chomp",
+			Services: []string{chompv1beta1connect.ChompServiceName},
+			Check: func(ctx context.Context) error {
+				return svc.Ping(ctx, cfg.HealthCheckAPIKey)
+			},
+		},
+	}
 }
@@ -0,0 +1,169 @@
+// Package dailyvalue computes how much of a reference daily intake a nutrient
+// amount provides, using reference profiles such as the FDA's Daily Values or
+// the EU's Reference Intakes.
+package dailyvalue
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/nutrients"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/units"
+	"os"
+)
+
+// DefaultProfile is the profile used when a request doesn't name one.
+const DefaultProfile = "fda"
+
+// Reference is the reference daily intake of a nutrient.
+type Reference struct {
+	Amount float64 `json:"amount"`
+	Unit   string  `json:"unit"`
+}
+
+// Profile is a named set of reference daily intakes, keyed by canonical
+// nutrient name (see nutrients.Canonical).
+type Profile struct {
+	Name      string               `json:"name"`
+	Nutrients map[string]Reference `json:"nutrients"`
+}
+
+// Profiles is a set of profiles, keyed by name.
+type Profiles map[string]Profile
+
+var fda = Profile{
+	Name: "fda",
+	Nutrients: map[string]Reference{
+		nutrients.Energy:       {Amount: 2000, Unit: "kcal"},
+		nutrients.Fat:          {Amount: 78, Unit: "g"},
+		nutrients.SaturatedFat: {Amount: 20, Unit: "g"},
+		nutrients.Cholesterol:  {Amount: 300, Unit: "mg"},
+		nutrients.Sodium:       {Amount: 2300, Unit: "mg"},
+		nutrients.Carbohydrate: {Amount: 275, Unit: "g"},
+		nutrients.Fiber:        {Amount: 28, Unit: "g"},
+		nutrients.AddedSugars:  {Amount: 50, Unit: "g"},
+		nutrients.Protein:      {Amount: 50, Unit: "g"},
+		nutrients.VitaminA:     {Amount: 900, Unit: "mcg"},
+		nutrients.VitaminC:     {Amount: 90, Unit: "mg"},
+		nutrients.VitaminD:     {Amount: 20, Unit: "mcg"},
+		nutrients.Calcium:      {Amount: 1300, Unit: "mg"},
+		nutrients.Iron:         {Amount: 18, Unit: "mg"},
+		nutrients.Potassium:    {Amount: 4700, Unit: "mg"},
+	},
+}
+
+// euRI holds the adult Reference Intakes from Annex XIII of Regulation (EU)
+// No 1169/2011. The regulation sets an intake for salt, not sodium.
+var euRI = Profile{
+	Name: "eu_ri",
+	Nutrients: map[string]Reference{
+		nutrients.Energy:       {Amount: 8400, Unit: "kJ"},
+		nutrients.Fat:          {Amount: 70, Unit: "g"},
+		nutrients.SaturatedFat: {Amount: 20, Unit: "g"},
+		nutrients.Carbohydrate: {Amount: 260, Unit: "g"},
+		nutrients.Sugars:       {Amount: 90, Unit: "g"},
+		nutrients.Protein:      {Amount: 50, Unit: "g"},
+		nutrients.Salt:         {Amount: 6, Unit: "g"},
+		nutrients.VitaminA:     {Amount: 800, Unit: "mcg"},
+		nutrients.VitaminC:     {Amount: 80, Unit: "mg"},
+		nutrients.VitaminD:     {Amount: 5, Unit: "mcg"},
+		nutrients.Calcium:      {Amount: 800, Unit: "mg"},
+		nutrients.Iron:         {Amount: 14, Unit: "mg"},
+		nutrients.Potassium:    {Amount: 2000, Unit: "mg"},
+	},
+}
+
+// Builtin returns the profiles that ship with the proxy.
+func Builtin() Profiles {
+	return Profiles{
+		fda.Name:  fda,
+		euRI.Name: euRI,
+	}
+}
+
+// file is the layout of a custom profiles file.
+type file struct {
+	Profiles []struct {
+		Profile
+		// Base optionally names a profile whose references are inherited and
+		// then overridden.
+		Base string `json:"base"`
+	} `json:"profiles"`
+}
+
+// Load returns the builtin profiles plus any custom profiles defined in the
+// JSON file at path. A custom profile may extend another profile by naming it
+// as its base, and its nutrients must be keyed by canonical name. An empty
+// path returns only the builtin profiles.
+func Load(path string) (Profiles, error) {
+	profiles := Builtin()
+	if path == "" {
+		return profiles, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read daily value profiles: %w", err)
+	}
+	var f file
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse daily value profiles: %w", err)
+	}
+
+	for _, p := range f.Profiles {
+		if p.Name == "" {
+			return nil, fmt.Errorf("daily value profile is missing a name")
+		}
+		refs := make(map[string]Reference)
+		if p.Base != "" {
+			base, ok := profiles[p.Base]
+			if !ok {
+				return nil, fmt.Errorf("daily value profile %q has unknown base %q", p.Name, p.Base)
+			}
+			for k, v := range base.Nutrients {
+				refs[k] = v
+			}
+		}
+		for k, v := range p.Nutrients {
+			if !nutrients.IsCanonical(k) {
+				return nil, fmt.Errorf("daily value profile %q has unknown nutrient %q", p.Name, k)
+			}
+			if v.Amount <= 0 {
+				return nil, fmt.Errorf("daily value profile %q has non-positive amount for %q", p.Name, k)
+			}
+			if _, ok := units.LookupUnit(v.Unit); !ok {
+				return nil, fmt.Errorf("daily value profile %q has unknown unit %q for %q", p.Name, v.Unit, k)
+			}
+			refs[k] = v
+		}
+		profiles[p.Name] = Profile{Name: p.Name, Nutrients: refs}
+	}
+	return profiles, nil
+}
+
+// Percent returns the percentage of the profile's reference intake that an
+// amount of a nutrient provides. It returns false if the profile has no
+// reference for the nutrient, or the amount's unit can't be converted to the
+// reference's unit (e.g. IU).
+func (p Profile) Percent(name string, amount float64, unit string) (Reference, float64, bool) {
+	key, ok := nutrients.Canonical(name)
+	if !ok {
+		return Reference{}, 0, false
+	}
+	ref, ok := p.Nutrients[key]
+	if !ok {
+		return Reference{}, 0, false
+	}
+	from, ok := units.LookupUnit(unit)
+	if !ok {
+		return Reference{}, 0, false
+	}
+	to, ok := units.LookupUnit(ref.Unit)
+	if !ok {
+		return Reference{}, 0, false
+	}
+	q, err := units.Convert(units.Quantity{Value: amount, Unit: from}, to)
+	if err != nil {
+		return Reference{}, 0, false
+	}
+	return ref, q.Value / ref.Amount * 100, true
+}
@@ -0,0 +1,90 @@
+package dailyvalue
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPercent(t *testing.T) {
+	profiles := Builtin()
+
+	tests := map[string]struct {
+		profile  string
+		name     string
+		amount   float64
+		unit     string
+		expected float64
+		ok       bool
+	}{
+		"fda sodium in grams":    {profile: "fda", name: "Sodium, Na", amount: 0.46, unit: "g", expected: 20, ok: true},
+		"fda energy":             {profile: "fda", name: "Energy", amount: 500, unit: "kcal", expected: 25, ok: true},
+		"eu energy in kcal":      {profile: "eu_ri", name: "Energy", amount: 500, unit: "kcal", expected: 24.904761904761905, ok: true},
+		"eu sugars":              {profile: "eu_ri", name: "Sugars, total", amount: 45, unit: "g", expected: 50, ok: true},
+		"fda has no total sugar": {profile: "fda", name: "Sugars, total", amount: 45, unit: "g"},
+		"eu has no sodium":       {profile: "eu_ri", name: "Sodium, Na", amount: 0.4, unit: "g"},
+		"unknown nutrient":       {profile: "fda", name: "Caffeine", amount: 10, unit: "mg"},
+		"unconvertible unit":     {profile: "fda", name: "Vitamin A", amount: 10, unit: "IU"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, pct, ok := profiles[tc.profile].Percent(tc.name, tc.amount, tc.unit)
+			require.Equal(t, tc.ok, ok)
+			require.InDelta(t, tc.expected, pct, 1e-9)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	profiles, err := Load("")
+	require.NoError(t, err)
+	require.Equal(t, Builtin(), profiles)
+
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	err = os.WriteFile(path, []byte(`{
+  "profiles": [
+    {
+      "name": "keto",
+      "base": "fda",
+      "nutrients": {
+        "carbohydrate": {"amount": 20, "unit": "g"}
+      }
+    }
+  ]
+}`), 0o600)
+	require.NoError(t, err)
+
+	profiles, err = Load(path)
+	require.NoError(t, err)
+	require.Contains(t, profiles, "fda")
+	require.Contains(t, profiles, "eu_ri")
+	require.Equal(t, Reference{Amount: 20, Unit: "g"}, profiles["keto"].Nutrients["carbohydrate"])
+	require.Equal(t, fda.Nutrients["protein"], profiles["keto"].Nutrients["protein"])
+	// The base profile must not be modified.
+	require.Equal(t, Reference{Amount: 275, Unit: "g"}, profiles["fda"].Nutrients["carbohydrate"])
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown base": `{"profiles": [{"name": "x", "base": "nope"}]}`,
+		"missing name": `{"profiles": [{"nutrients": {}}]}`,
+		"bad unit":     `{"profiles": [{"name": "x", "nutrients": {"protein": {"amount": 1, "unit": "scoops"}}}]}`,
+		"bad amount":   `{"profiles": [{"name": "x", "nutrients": {"protein": {"amount": 0, "unit": "g"}}}]}`,
+		"alias key":    `{"profiles": [{"name": "x", "nutrients": {"Sodium, Na": {"amount": 1, "unit": "g"}}}]}`,
+		"padded key":   `{"profiles": [{"name": "x", "nutrients": {"vitamin_c ": {"amount": 1, "unit": "mg"}}}]}`,
+		"unit in key":  `{"profiles": [{"name": "x", "nutrients": {"sodium_mg": {"amount": 1, "unit": "mg"}}}]}`,
+		"bad json":     `{`,
+	}
+	for name, contents := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "profiles.json")
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+			_, err := Load(path)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
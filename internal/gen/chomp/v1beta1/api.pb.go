@@ -0,0 +1,1969 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: chomp/v1beta1/api.proto
+
+package chompv1beta1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The system of measurement used for serving sizes, package sizes and
+// nutrient amounts in responses
+type UnitSystem int32
+
+const (
+	// Behaves like UNIT_SYSTEM_AS_IS
+	UnitSystem_UNIT_SYSTEM_UNSPECIFIED UnitSystem = 0
+	// Leave amounts in the units Chomp reports them in
+	UnitSystem_UNIT_SYSTEM_AS_IS UnitSystem = 1
+	// Grams, milliliters and kilojoules
+	UnitSystem_UNIT_SYSTEM_METRIC UnitSystem = 2
+	// Ounces, fluid ounces and kilocalories. Kitchen measures like cups and
+	// tablespoons are kept as-is.
+	UnitSystem_UNIT_SYSTEM_IMPERIAL UnitSystem = 3
+)
+
+// Enum value maps for UnitSystem.
+var (
+	UnitSystem_name = map[int32]string{
+		0: "UNIT_SYSTEM_UNSPECIFIED",
+		1: "UNIT_SYSTEM_AS_IS",
+		2: "UNIT_SYSTEM_METRIC",
+		3: "UNIT_SYSTEM_IMPERIAL",
+	}
+	UnitSystem_value = map[string]int32{
+		"UNIT_SYSTEM_UNSPECIFIED": 0,
+		"UNIT_SYSTEM_AS_IS":       1,
+		"UNIT_SYSTEM_METRIC":      2,
+		"UNIT_SYSTEM_IMPERIAL":    3,
+	}
+)
+
+func (x UnitSystem) Enum() *UnitSystem {
+	p := new(UnitSystem)
+	*p = x
+	return p
+}
+
+func (x UnitSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnitSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_chomp_v1beta1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (UnitSystem) Type() protoreflect.EnumType {
+	return &file_chomp_v1beta1_api_proto_enumTypes[0]
+}
+
+func (x UnitSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnitSystem.Descriptor instead.
+func (UnitSystem) EnumDescriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{0}
+}
+
+// A diet that food items can be graded for
+type Diet int32
+
+const (
+	Diet_DIET_UNSPECIFIED Diet = 0
+	Diet_DIET_VEGAN       Diet = 1
+	Diet_DIET_VEGETARIAN  Diet = 2
+	Diet_DIET_GLUTEN_FREE Diet = 3
+)
+
+// Enum value maps for Diet.
+var (
+	Diet_name = map[int32]string{
+		0: "DIET_UNSPECIFIED",
+		1: "DIET_VEGAN",
+		2: "DIET_VEGETARIAN",
+		3: "DIET_GLUTEN_FREE",
+	}
+	Diet_value = map[string]int32{
+		"DIET_UNSPECIFIED": 0,
+		"DIET_VEGAN":       1,
+		"DIET_VEGETARIAN":  2,
+		"DIET_GLUTEN_FREE": 3,
+	}
+)
+
+func (x Diet) Enum() *Diet {
+	p := new(Diet)
+	*p = x
+	return p
+}
+
+func (x Diet) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Diet) Descriptor() protoreflect.EnumDescriptor {
+	return file_chomp_v1beta1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (Diet) Type() protoreflect.EnumType {
+	return &file_chomp_v1beta1_api_proto_enumTypes[1]
+}
+
+func (x Diet) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Diet.Descriptor instead.
+func (Diet) EnumDescriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{1}
+}
+
+type GetFoodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UPC/EAN barcode
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// The system of measurement to express amounts in
+	UnitSystem UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=chomp.v1beta1.UnitSystem" json:"unit_system,omitempty"`
+	// The reference intake profile used to compute each nutrient's daily value,
+	// e.g. "fda" (the default) or "eu_ri"
+	DailyValueProfile string `protobuf:"bytes,3,opt,name=daily_value_profile,json=dailyValueProfile,proto3" json:"daily_value_profile,omitempty"`
+}
+
+func (x *GetFoodRequest) Reset() {
+	*x = GetFoodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFoodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoodRequest) ProtoMessage() {}
+
+func (x *GetFoodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoodRequest.ProtoReflect.Descriptor instead.
+func (*GetFoodRequest) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetFoodRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetFoodRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+func (x *GetFoodRequest) GetDailyValueProfile() string {
+	if x != nil {
+		return x.DailyValueProfile
+	}
+	return ""
+}
+
+type GetFoodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Food *Food `protobuf:"bytes,1,opt,name=food,proto3" json:"food,omitempty"`
+}
+
+func (x *GetFoodResponse) Reset() {
+	*x = GetFoodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFoodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoodResponse) ProtoMessage() {}
+
+func (x *GetFoodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoodResponse.ProtoReflect.Descriptor instead.
+func (*GetFoodResponse) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFoodResponse) GetFood() *Food {
+	if x != nil {
+		return x.Food
+	}
+	return nil
+}
+
+type ListFoodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Search for branded food items using a general food name keyword. This does
+	// not have to exactly match the "official" name for the food.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Set maximum number of records you want the API to return. The default value
+	// is "10."
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// This is how you paginate the search result. By default, you will see the
+	// first 10 records. You must increment the page number to access the next 10
+	// records, and so on. The default value is "1."
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// The system of measurement to express amounts in
+	UnitSystem UnitSystem `protobuf:"varint,4,opt,name=unit_system,json=unitSystem,proto3,enum=chomp.v1beta1.UnitSystem" json:"unit_system,omitempty"`
+	// The reference intake profile used to compute each nutrient's daily value,
+	// e.g. "fda" (the default) or "eu_ri"
+	DailyValueProfile string `protobuf:"bytes,5,opt,name=daily_value_profile,json=dailyValueProfile,proto3" json:"daily_value_profile,omitempty"`
+}
+
+func (x *ListFoodsRequest) Reset() {
+	*x = ListFoodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoodsRequest) ProtoMessage() {}
+
+func (x *ListFoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoodsRequest.ProtoReflect.Descriptor instead.
+func (*ListFoodsRequest) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListFoodsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListFoodsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFoodsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFoodsRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+func (x *ListFoodsRequest) GetDailyValueProfile() string {
+	if x != nil {
+		return x.DailyValueProfile
+	}
+	return ""
+}
+
+type ListFoodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Food `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ListFoodsResponse) Reset() {
+	*x = ListFoodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoodsResponse) ProtoMessage() {}
+
+func (x *ListFoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoodsResponse.ProtoReflect.Descriptor instead.
+func (*ListFoodsResponse) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFoodsResponse) GetItems() []*Food {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CalculateMealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The food items that make up the meal
+	Items []*MealItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// The system of measurement to express amounts in
+	UnitSystem UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=chomp.v1beta1.UnitSystem" json:"unit_system,omitempty"`
+	// The reference intake profile used to compute each nutrient's daily value,
+	// e.g. "fda" (the default) or "eu_ri"
+	DailyValueProfile string `protobuf:"bytes,3,opt,name=daily_value_profile,json=dailyValueProfile,proto3" json:"daily_value_profile,omitempty"`
+}
+
+func (x *CalculateMealRequest) Reset() {
+	*x = CalculateMealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateMealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateMealRequest) ProtoMessage() {}
+
+func (x *CalculateMealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateMealRequest.ProtoReflect.Descriptor instead.
+func (*CalculateMealRequest) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CalculateMealRequest) GetItems() []*MealItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CalculateMealRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+func (x *CalculateMealRequest) GetDailyValueProfile() string {
+	if x != nil {
+		return x.DailyValueProfile
+	}
+	return ""
+}
+
+// A food item eaten as part of a meal
+type MealItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UPC/EAN barcode
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// How much of the item was eaten, in the given unit
+	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// The unit of quantity. This can be a unit of mass or volume (e.g. "g",
+	// "oz", "cup"), "serving" or "package". Defaults to "serving".
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *MealItem) Reset() {
+	*x = MealItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MealItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealItem) ProtoMessage() {}
+
+func (x *MealItem) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealItem.ProtoReflect.Descriptor instead.
+func (*MealItem) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MealItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *MealItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MealItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type CalculateMealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The combined amount of each nutrient across all items in the meal
+	Nutrients []*NutrientTotal `protobuf:"bytes,1,rep,name=nutrients,proto3" json:"nutrients,omitempty"`
+	// The union of allergens across all items in the meal
+	Allergens []string `protobuf:"bytes,2,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	// The union of trace ingredients across all items in the meal
+	Traces []string `protobuf:"bytes,3,rep,name=traces,proto3" json:"traces,omitempty"`
+	// The meal's compatibility with each supported diet, which is that of its
+	// least compatible item
+	DietLabels *DietLabels `protobuf:"bytes,4,opt,name=diet_labels,json=dietLabels,proto3" json:"diet_labels,omitempty"`
+	// The items that make up the meal, in request order
+	Items []*MealItemResult `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CalculateMealResponse) Reset() {
+	*x = CalculateMealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateMealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateMealResponse) ProtoMessage() {}
+
+func (x *CalculateMealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateMealResponse.ProtoReflect.Descriptor instead.
+func (*CalculateMealResponse) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalculateMealResponse) GetNutrients() []*NutrientTotal {
+	if x != nil {
+		return x.Nutrients
+	}
+	return nil
+}
+
+func (x *CalculateMealResponse) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *CalculateMealResponse) GetTraces() []string {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
+func (x *CalculateMealResponse) GetDietLabels() *DietLabels {
+	if x != nil {
+		return x.DietLabels
+	}
+	return nil
+}
+
+func (x *CalculateMealResponse) GetItems() []*MealItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// The total amount of a nutrient across the items in a meal
+type NutrientTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nutrient name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Total amount of the nutrient
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The unit used for the measure of this nutrient
+	MeasurementUnit string `protobuf:"bytes,3,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	// Number of items in the meal that reported this nutrient
+	ItemCount int32 `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+}
+
+func (x *NutrientTotal) Reset() {
+	*x = NutrientTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutrientTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutrientTotal) ProtoMessage() {}
+
+func (x *NutrientTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutrientTotal.ProtoReflect.Descriptor instead.
+func (*NutrientTotal) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NutrientTotal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NutrientTotal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *NutrientTotal) GetMeasurementUnit() string {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return ""
+}
+
+func (x *NutrientTotal) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+// A meal item resolved to a food
+type MealItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested item
+	Item *MealItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// The food the item's barcode resolved to
+	Food *Food `protobuf:"bytes,2,opt,name=food,proto3" json:"food,omitempty"`
+	// The weight of the eaten quantity in grams
+	Grams float64 `protobuf:"fixed64,3,opt,name=grams,proto3" json:"grams,omitempty"`
+}
+
+func (x *MealItemResult) Reset() {
+	*x = MealItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MealItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealItemResult) ProtoMessage() {}
+
+func (x *MealItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealItemResult.ProtoReflect.Descriptor instead.
+func (*MealItemResult) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MealItemResult) GetItem() *MealItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *MealItemResult) GetFood() *Food {
+	if x != nil {
+		return x.Food
+	}
+	return nil
+}
+
+func (x *MealItemResult) GetGrams() float64 {
+	if x != nil {
+		return x.Grams
+	}
+	return 0
+}
+
+type CompareFoodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UPC/EAN barcodes of the items to compare
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	// The system of measurement to express amounts in
+	UnitSystem UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=chomp.v1beta1.UnitSystem" json:"unit_system,omitempty"`
+	// The reference intake profile used to compute each nutrient's daily value,
+	// e.g. "fda" (the default) or "eu_ri"
+	DailyValueProfile string `protobuf:"bytes,3,opt,name=daily_value_profile,json=dailyValueProfile,proto3" json:"daily_value_profile,omitempty"`
+}
+
+func (x *CompareFoodsRequest) Reset() {
+	*x = CompareFoodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareFoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareFoodsRequest) ProtoMessage() {}
+
+func (x *CompareFoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareFoodsRequest.ProtoReflect.Descriptor instead.
+func (*CompareFoodsRequest) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CompareFoodsRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *CompareFoodsRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+func (x *CompareFoodsRequest) GetDailyValueProfile() string {
+	if x != nil {
+		return x.DailyValueProfile
+	}
+	return ""
+}
+
+type CompareFoodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The compared items, in request order. Every repeated field in the
+	// comparisons below is indexed the same way.
+	Foods []*Food `protobuf:"bytes,1,rep,name=foods,proto3" json:"foods,omitempty"`
+	// Per-100g amounts of every nutrient reported by any of the items
+	Nutrients []*NutrientComparison `protobuf:"bytes,2,rep,name=nutrients,proto3" json:"nutrients,omitempty"`
+	// Every allergen reported by any of the items
+	Allergens []*PresenceComparison `protobuf:"bytes,3,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	// Every trace ingredient reported by any of the items
+	Traces []*PresenceComparison `protobuf:"bytes,4,rep,name=traces,proto3" json:"traces,omitempty"`
+	// Every ingredient listed by any of the items
+	Ingredients []*PresenceComparison `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	// Each item's compatibility with each supported diet
+	Diets []*DietComparison `protobuf:"bytes,6,rep,name=diets,proto3" json:"diets,omitempty"`
+}
+
+func (x *CompareFoodsResponse) Reset() {
+	*x = CompareFoodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareFoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareFoodsResponse) ProtoMessage() {}
+
+func (x *CompareFoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareFoodsResponse.ProtoReflect.Descriptor instead.
+func (*CompareFoodsResponse) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CompareFoodsResponse) GetFoods() []*Food {
+	if x != nil {
+		return x.Foods
+	}
+	return nil
+}
+
+func (x *CompareFoodsResponse) GetNutrients() []*NutrientComparison {
+	if x != nil {
+		return x.Nutrients
+	}
+	return nil
+}
+
+func (x *CompareFoodsResponse) GetAllergens() []*PresenceComparison {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *CompareFoodsResponse) GetTraces() []*PresenceComparison {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
+func (x *CompareFoodsResponse) GetIngredients() []*PresenceComparison {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *CompareFoodsResponse) GetDiets() []*DietComparison {
+	if x != nil {
+		return x.Diets
+	}
+	return nil
+}
+
+// A nutrient's per-100g amount across the compared items
+type NutrientComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nutrient name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The unit all values are expressed in
+	MeasurementUnit string `protobuf:"bytes,2,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	// The amount in each item
+	Values []*ComparedValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *NutrientComparison) Reset() {
+	*x = NutrientComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutrientComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutrientComparison) ProtoMessage() {}
+
+func (x *NutrientComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutrientComparison.ProtoReflect.Descriptor instead.
+func (*NutrientComparison) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NutrientComparison) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NutrientComparison) GetMeasurementUnit() string {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return ""
+}
+
+func (x *NutrientComparison) GetValues() []*ComparedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// A value that may be missing for some of the compared items
+type ComparedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the item reported this value
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// The value, if available
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ComparedValue) Reset() {
+	*x = ComparedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComparedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparedValue) ProtoMessage() {}
+
+func (x *ComparedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparedValue.ProtoReflect.Descriptor instead.
+func (*ComparedValue) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ComparedValue) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *ComparedValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Whether each compared item has a particular allergen, trace or ingredient
+type PresenceComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the allergen, trace or ingredient
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether each item has it
+	Present []bool `protobuf:"varint,2,rep,packed,name=present,proto3" json:"present,omitempty"`
+	// Whether all items have it
+	Shared bool `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+}
+
+func (x *PresenceComparison) Reset() {
+	*x = PresenceComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresenceComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceComparison) ProtoMessage() {}
+
+func (x *PresenceComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceComparison.ProtoReflect.Descriptor instead.
+func (*PresenceComparison) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PresenceComparison) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PresenceComparison) GetPresent() []bool {
+	if x != nil {
+		return x.Present
+	}
+	return nil
+}
+
+func (x *PresenceComparison) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+// Each compared item's compatibility with a diet
+type DietComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the diet (vegan, vegetarian or gluten_free)
+	Diet string `protobuf:"bytes,1,opt,name=diet,proto3" json:"diet,omitempty"`
+	// Each item's label for the diet
+	Labels []*DietLabel `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Whether the items disagree on compatibility with the diet
+	Differs bool `protobuf:"varint,3,opt,name=differs,proto3" json:"differs,omitempty"`
+}
+
+func (x *DietComparison) Reset() {
+	*x = DietComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DietComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DietComparison) ProtoMessage() {}
+
+func (x *DietComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DietComparison.ProtoReflect.Descriptor instead.
+func (*DietComparison) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DietComparison) GetDiet() string {
+	if x != nil {
+		return x.Diet
+	}
+	return ""
+}
+
+func (x *DietComparison) GetLabels() []*DietLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DietComparison) GetDiffers() bool {
+	if x != nil {
+		return x.Differs
+	}
+	return false
+}
+
+type SuggestAlternativesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UPC/EAN barcode of the item to find alternatives for
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Diets that alternatives must be compatible with
+	Diets []Diet `protobuf:"varint,2,rep,packed,name=diets,proto3,enum=chomp.v1beta1.Diet" json:"diets,omitempty"`
+	// Allergens that alternatives must not contain (e.g. "milk"). They're
+	// compared by canonical allergen name, so "nuts" excludes items with
+	// cashews but not items with coconut.
+	ExcludeAllergens []string `protobuf:"bytes,3,rep,name=exclude_allergens,json=excludeAllergens,proto3" json:"exclude_allergens,omitempty"`
+	// Whether alternatives that may contain traces of an excluded allergen are
+	// also excluded
+	ExcludeTraces bool `protobuf:"varint,4,opt,name=exclude_traces,json=excludeTraces,proto3" json:"exclude_traces,omitempty"`
+	// Maximum number of alternatives to return. The default value is 5.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The system of measurement to express amounts in
+	UnitSystem UnitSystem `protobuf:"varint,6,opt,name=unit_system,json=unitSystem,proto3,enum=chomp.v1beta1.UnitSystem" json:"unit_system,omitempty"`
+	// The reference intake profile used to compute each nutrient's daily value,
+	// e.g. "fda" (the default) or "eu_ri"
+	DailyValueProfile string `protobuf:"bytes,7,opt,name=daily_value_profile,json=dailyValueProfile,proto3" json:"daily_value_profile,omitempty"`
+}
+
+func (x *SuggestAlternativesRequest) Reset() {
+	*x = SuggestAlternativesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestAlternativesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativesRequest) ProtoMessage() {}
+
+func (x *SuggestAlternativesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativesRequest) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SuggestAlternativesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SuggestAlternativesRequest) GetDiets() []Diet {
+	if x != nil {
+		return x.Diets
+	}
+	return nil
+}
+
+func (x *SuggestAlternativesRequest) GetExcludeAllergens() []string {
+	if x != nil {
+		return x.ExcludeAllergens
+	}
+	return nil
+}
+
+func (x *SuggestAlternativesRequest) GetExcludeTraces() bool {
+	if x != nil {
+		return x.ExcludeTraces
+	}
+	return false
+}
+
+func (x *SuggestAlternativesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SuggestAlternativesRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+func (x *SuggestAlternativesRequest) GetDailyValueProfile() string {
+	if x != nil {
+		return x.DailyValueProfile
+	}
+	return ""
+}
+
+type SuggestAlternativesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The item alternatives were requested for
+	Food *Food `protobuf:"bytes,1,opt,name=food,proto3" json:"food,omitempty"`
+	// Alternatives that satisfy the constraints, best first
+	Alternatives []*Alternative `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+}
+
+func (x *SuggestAlternativesResponse) Reset() {
+	*x = SuggestAlternativesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestAlternativesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativesResponse) ProtoMessage() {}
+
+func (x *SuggestAlternativesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativesResponse) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SuggestAlternativesResponse) GetFood() *Food {
+	if x != nil {
+		return x.Food
+	}
+	return nil
+}
+
+func (x *SuggestAlternativesResponse) GetAlternatives() []*Alternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+// A food item suggested as an alternative to another
+type Alternative struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The suggested item
+	Food *Food `protobuf:"bytes,1,opt,name=food,proto3" json:"food,omitempty"`
+	// How similar the item is to the original, from 0 to 1, based on shared
+	// categories, keywords and name
+	Similarity float64 `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	// The score alternatives are ranked by, from 0 to 1, combining similarity
+	// and nutrition grade
+	RankScore float64 `protobuf:"fixed64,3,opt,name=rank_score,json=rankScore,proto3" json:"rank_score,omitempty"`
+	// Categories the item shares with the original
+	SharedCategories []string `protobuf:"bytes,4,rep,name=shared_categories,json=sharedCategories,proto3" json:"shared_categories,omitempty"`
+}
+
+func (x *Alternative) Reset() {
+	*x = Alternative{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alternative) ProtoMessage() {}
+
+func (x *Alternative) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alternative.ProtoReflect.Descriptor instead.
+func (*Alternative) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Alternative) GetFood() *Food {
+	if x != nil {
+		return x.Food
+	}
+	return nil
+}
+
+func (x *Alternative) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *Alternative) GetRankScore() float64 {
+	if x != nil {
+		return x.RankScore
+	}
+	return 0
+}
+
+func (x *Alternative) GetSharedCategories() []string {
+	if x != nil {
+		return x.SharedCategories
+	}
+	return nil
+}
+
+var File_chomp_v1beta1_api_proto protoreflect.FileDescriptor
+
+var file_chomp_v1beta1_api_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x63, 0x68, 0x6f, 0x6d, 0x70,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x18, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x6f, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x2e,
+	0x0a, 0x13, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x3a,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x46, 0x6f, 0x6f, 0x64, 0x52, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68,
+	0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f,
+	0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x14, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68,
+	0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x22, 0x67, 0x0a, 0x08, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa,
+	0x42, 0x0b, 0x12, 0x09, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x15,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e,
+	0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x4e, 0x75, 0x74,
+	0x72, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x7c, 0x0a, 0x0e, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12,
+	0x27, 0x0a, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x6f,
+	0x6f, 0x64, 0x52, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa5,
+	0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x08, 0x02, 0x10,
+	0x05, 0x18, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x55, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xf8, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x05, 0x66, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46,
+	0x6f, 0x6f, 0x64, 0x52, 0x05, 0x66, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x6e, 0x75,
+	0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4e, 0x75,
+	0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x52, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x05,
+	0x64, 0x69, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68,
+	0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x69, 0x65, 0x74,
+	0x73, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x43, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x22, 0x70,
+	0x0a, 0x0e, 0x44, 0x69, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x69, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x22, 0xc5, 0x02, 0x0a, 0x1a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x64, 0x69, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x68,
+	0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74,
+	0x52, 0x05, 0x64, 0x69, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a,
+	0x04, 0x18, 0x14, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0b,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x75, 0x6e,
+	0x69, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x6f, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x6f, 0x64, 0x52, 0x04, 0x66, 0x6f, 0x6f,
+	0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0b, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x46, 0x6f, 0x6f, 0x64, 0x52, 0x04, 0x66, 0x6f, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61,
+	0x6e, 0x6b, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x72, 0x61, 0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2a, 0x72, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x53,
+	0x54, 0x45, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d,
+	0x5f, 0x41, 0x53, 0x5f, 0x49, 0x53, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x49, 0x54,
+	0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x10, 0x02,
+	0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f,
+	0x49, 0x4d, 0x50, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x57, 0x0a, 0x04, 0x44, 0x69,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x45, 0x54,
+	0x5f, 0x56, 0x45, 0x47, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x45, 0x54,
+	0x5f, 0x56, 0x45, 0x47, 0x45, 0x54, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x44, 0x49, 0x45, 0x54, 0x5f, 0x47, 0x4c, 0x55, 0x54, 0x45, 0x4e, 0x5f, 0x46, 0x52, 0x45,
+	0x45, 0x10, 0x03, 0x32, 0xd5, 0x03, 0x0a, 0x0c, 0x43, 0x68, 0x6f, 0x6d, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x12,
+	0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x1f, 0x2e,
+	0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x64, 0x73,
+	0x12, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x46, 0x6f, 0x6f, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x13, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chomp_v1beta1_api_proto_rawDescOnce sync.Once
+	file_chomp_v1beta1_api_proto_rawDescData = file_chomp_v1beta1_api_proto_rawDesc
+)
+
+func file_chomp_v1beta1_api_proto_rawDescGZIP() []byte {
+	file_chomp_v1beta1_api_proto_rawDescOnce.Do(func() {
+		file_chomp_v1beta1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_chomp_v1beta1_api_proto_rawDescData)
+	})
+	return file_chomp_v1beta1_api_proto_rawDescData
+}
+
+var file_chomp_v1beta1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_chomp_v1beta1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_chomp_v1beta1_api_proto_goTypes = []interface{}{
+	(UnitSystem)(0),                     // 0: chomp.v1beta1.UnitSystem
+	(Diet)(0),                           // 1: chomp.v1beta1.Diet
+	(*GetFoodRequest)(nil),              // 2: chomp.v1beta1.GetFoodRequest
+	(*GetFoodResponse)(nil),             // 3: chomp.v1beta1.GetFoodResponse
+	(*ListFoodsRequest)(nil),            // 4: chomp.v1beta1.ListFoodsRequest
+	(*ListFoodsResponse)(nil),           // 5: chomp.v1beta1.ListFoodsResponse
+	(*CalculateMealRequest)(nil),        // 6: chomp.v1beta1.CalculateMealRequest
+	(*MealItem)(nil),                    // 7: chomp.v1beta1.MealItem
+	(*CalculateMealResponse)(nil),       // 8: chomp.v1beta1.CalculateMealResponse
+	(*NutrientTotal)(nil),               // 9: chomp.v1beta1.NutrientTotal
+	(*MealItemResult)(nil),              // 10: chomp.v1beta1.MealItemResult
+	(*CompareFoodsRequest)(nil),         // 11: chomp.v1beta1.CompareFoodsRequest
+	(*CompareFoodsResponse)(nil),        // 12: chomp.v1beta1.CompareFoodsResponse
+	(*NutrientComparison)(nil),          // 13: chomp.v1beta1.NutrientComparison
+	(*ComparedValue)(nil),               // 14: chomp.v1beta1.ComparedValue
+	(*PresenceComparison)(nil),          // 15: chomp.v1beta1.PresenceComparison
+	(*DietComparison)(nil),              // 16: chomp.v1beta1.DietComparison
+	(*SuggestAlternativesRequest)(nil),  // 17: chomp.v1beta1.SuggestAlternativesRequest
+	(*SuggestAlternativesResponse)(nil), // 18: chomp.v1beta1.SuggestAlternativesResponse
+	(*Alternative)(nil),                 // 19: chomp.v1beta1.Alternative
+	(*Food)(nil),                        // 20: chomp.v1beta1.Food
+	(*DietLabels)(nil),                  // 21: chomp.v1beta1.DietLabels
+	(*DietLabel)(nil),                   // 22: chomp.v1beta1.DietLabel
+}
+var file_chomp_v1beta1_api_proto_depIdxs = []int32{
+	0,  // 0: chomp.v1beta1.GetFoodRequest.unit_system:type_name -> chomp.v1beta1.UnitSystem
+	20, // 1: chomp.v1beta1.GetFoodResponse.food:type_name -> chomp.v1beta1.Food
+	0,  // 2: chomp.v1beta1.ListFoodsRequest.unit_system:type_name -> chomp.v1beta1.UnitSystem
+	20, // 3: chomp.v1beta1.ListFoodsResponse.items:type_name -> chomp.v1beta1.Food
+	7,  // 4: chomp.v1beta1.CalculateMealRequest.items:type_name -> chomp.v1beta1.MealItem
+	0,  // 5: chomp.v1beta1.CalculateMealRequest.unit_system:type_name -> chomp.v1beta1.UnitSystem
+	9,  // 6: chomp.v1beta1.CalculateMealResponse.nutrients:type_name -> chomp.v1beta1.NutrientTotal
+	21, // 7: chomp.v1beta1.CalculateMealResponse.diet_labels:type_name -> chomp.v1beta1.DietLabels
+	10, // 8: chomp.v1beta1.CalculateMealResponse.items:type_name -> chomp.v1beta1.MealItemResult
+	7,  // 9: chomp.v1beta1.MealItemResult.item:type_name -> chomp.v1beta1.MealItem
+	20, // 10: chomp.v1beta1.MealItemResult.food:type_name -> chomp.v1beta1.Food
+	0,  // 11: chomp.v1beta1.CompareFoodsRequest.unit_system:type_name -> chomp.v1beta1.UnitSystem
+	20, // 12: chomp.v1beta1.CompareFoodsResponse.foods:type_name -> chomp.v1beta1.Food
+	13, // 13: chomp.v1beta1.CompareFoodsResponse.nutrients:type_name -> chomp.v1beta1.NutrientComparison
+	15, // 14: chomp.v1beta1.CompareFoodsResponse.allergens:type_name -> chomp.v1beta1.PresenceComparison
+	15, // 15: chomp.v1beta1.CompareFoodsResponse.traces:type_name -> chomp.v1beta1.PresenceComparison
+	15, // 16: chomp.v1beta1.CompareFoodsResponse.ingredients:type_name -> chomp.v1beta1.PresenceComparison
+	16, // 17: chomp.v1beta1.CompareFoodsResponse.diets:type_name -> chomp.v1beta1.DietComparison
+	14, // 18: chomp.v1beta1.NutrientComparison.values:type_name -> chomp.v1beta1.ComparedValue
+	22, // 19: chomp.v1beta1.DietComparison.labels:type_name -> chomp.v1beta1.DietLabel
+	1,  // 20: chomp.v1beta1.SuggestAlternativesRequest.diets:type_name -> chomp.v1beta1.Diet
+	0,  // 21: chomp.v1beta1.SuggestAlternativesRequest.unit_system:type_name -> chomp.v1beta1.UnitSystem
+	20, // 22: chomp.v1beta1.SuggestAlternativesResponse.food:type_name -> chomp.v1beta1.Food
+	19, // 23: chomp.v1beta1.SuggestAlternativesResponse.alternatives:type_name -> chomp.v1beta1.Alternative
+	20, // 24: chomp.v1beta1.Alternative.food:type_name -> chomp.v1beta1.Food
+	2,  // 25: chomp.v1beta1.ChompService.GetFood:input_type -> chomp.v1beta1.GetFoodRequest
+	4,  // 26: chomp.v1beta1.ChompService.ListFoods:input_type -> chomp.v1beta1.ListFoodsRequest
+	6,  // 27: chomp.v1beta1.ChompService.CalculateMeal:input_type -> chomp.v1beta1.CalculateMealRequest
+	11, // 28: chomp.v1beta1.ChompService.CompareFoods:input_type -> chomp.v1beta1.CompareFoodsRequest
+	17, // 29: chomp.v1beta1.ChompService.SuggestAlternatives:input_type -> chomp.v1beta1.SuggestAlternativesRequest
+	3,  // 30: chomp.v1beta1.ChompService.GetFood:output_type -> chomp.v1beta1.GetFoodResponse
+	5,  // 31: chomp.v1beta1.ChompService.ListFoods:output_type -> chomp.v1beta1.ListFoodsResponse
+	8,  // 32: chomp.v1beta1.ChompService.CalculateMeal:output_type -> chomp.v1beta1.CalculateMealResponse
+	12, // 33: chomp.v1beta1.ChompService.CompareFoods:output_type -> chomp.v1beta1.CompareFoodsResponse
+	18, // 34: chomp.v1beta1.ChompService.SuggestAlternatives:output_type -> chomp.v1beta1.SuggestAlternativesResponse
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_chomp_v1beta1_api_proto_init() }
+func file_chomp_v1beta1_api_proto_init() {
+	if File_chomp_v1beta1_api_proto != nil {
+		return
+	}
+	file_chomp_v1beta1_food_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_chomp_v1beta1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFoodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFoodResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFoodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFoodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateMealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MealItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateMealResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutrientTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MealItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareFoodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareFoodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutrientComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComparedValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresenceComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DietComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestAlternativesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestAlternativesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alternative); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chomp_v1beta1_api_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_chomp_v1beta1_api_proto_goTypes,
+		DependencyIndexes: file_chomp_v1beta1_api_proto_depIdxs,
+		EnumInfos:         file_chomp_v1beta1_api_proto_enumTypes,
+		MessageInfos:      file_chomp_v1beta1_api_proto_msgTypes,
+	}.Build()
+	File_chomp_v1beta1_api_proto = out.File
+	file_chomp_v1beta1_api_proto_rawDesc = nil
+	file_chomp_v1beta1_api_proto_goTypes = nil
+	file_chomp_v1beta1_api_proto_depIdxs = nil
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: chomp/v1beta1/api.proto
+
+package chompv1beta1connect
+
+import (
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	v1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// ChompServiceName is the fully-qualified name of the ChompService service.
+	ChompServiceName = "chomp.v1beta1.ChompService"
+)
+
+// ChompServiceClient is a client for the chomp.v1beta1.ChompService service.
+type ChompServiceClient interface {
+	// Get data for a branded food using the food's UPC/EAN barcode.
+	//
+	// https://app.swaggerhub.com/apis-docs/chomp/Chomp/1.0.0-oas3#/default/get_food_branded_barcode_php
+	GetFood(context.Context, *connect_go.Request[v1beta1.GetFoodRequest]) (*connect_go.Response[v1beta1.GetFoodResponse], error)
+	// Search for branded food items by name.
+	//
+	// https://app.swaggerhub.com/apis-docs/chomp/Chomp/1.0.0-oas3#/default/get_food_branded_name_php
+	ListFoods(context.Context, *connect_go.Request[v1beta1.ListFoodsRequest]) (*connect_go.Response[v1beta1.ListFoodsResponse], error)
+	// Calculate the combined nutrition of a meal made up of several branded
+	// food items, each looked up by barcode like GetFood.
+	CalculateMeal(context.Context, *connect_go.Request[v1beta1.CalculateMealRequest]) (*connect_go.Response[v1beta1.CalculateMealResponse], error)
+	// Compare several branded food items side by side, each looked up by
+	// barcode like GetFood.
+	CompareFoods(context.Context, *connect_go.Request[v1beta1.CompareFoodsRequest]) (*connect_go.Response[v1beta1.CompareFoodsResponse], error)
+	// Suggest alternatives to a branded food item that satisfy dietary
+	// constraints, ranked by similarity and nutrition grade. Candidates are
+	// found with several searches; if some of them fail, the alternatives the
+	// others found are still suggested.
+	//
+	// https://app.swaggerhub.com/apis-docs/chomp/Chomp/1.0.0-oas3#/default/get_food_branded_search_php
+	SuggestAlternatives(context.Context, *connect_go.Request[v1beta1.SuggestAlternativesRequest]) (*connect_go.Response[v1beta1.SuggestAlternativesResponse], error)
+}
+
+// NewChompServiceClient constructs a client for the chomp.v1beta1.ChompService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChompServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ChompServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &chompServiceClient{
+		getFood: connect_go.NewClient[v1beta1.GetFoodRequest, v1beta1.GetFoodResponse](
+			httpClient,
+			baseURL+"/chomp.v1beta1.ChompService/GetFood",
+			opts...,
+		),
+		listFoods: connect_go.NewClient[v1beta1.ListFoodsRequest, v1beta1.ListFoodsResponse](
+			httpClient,
+			baseURL+"/chomp.v1beta1.ChompService/ListFoods",
+			opts...,
+		),
+		calculateMeal: connect_go.NewClient[v1beta1.CalculateMealRequest, v1beta1.CalculateMealResponse](
+			httpClient,
+			baseURL+"/chomp.v1beta1.ChompService/CalculateMeal",
+			opts...,
+		),
+		compareFoods: connect_go.NewClient[v1beta1.CompareFoodsRequest, v1beta1.CompareFoodsResponse](
+			httpClient,
+			baseURL+"/chomp.v1beta1.ChompService/CompareFoods",
+			opts...,
+		),
+		suggestAlternatives: connect_go.NewClient[v1beta1.SuggestAlternativesRequest, v1beta1.SuggestAlternativesResponse](
+			httpClient,
+			baseURL+"/chomp.v1beta1.ChompService/SuggestAlternatives",
+			opts...,
+		),
+	}
+}
+
+// chompServiceClient implements ChompServiceClient.
+type chompServiceClient struct {
+	getFood             *connect_go.Client[v1beta1.GetFoodRequest, v1beta1.GetFoodResponse]
+	listFoods           *connect_go.Client[v1beta1.ListFoodsRequest, v1beta1.ListFoodsResponse]
+	calculateMeal       *connect_go.Client[v1beta1.CalculateMealRequest, v1beta1.CalculateMealResponse]
+	compareFoods        *connect_go.Client[v1beta1.CompareFoodsRequest, v1beta1.CompareFoodsResponse]
+	suggestAlternatives *connect_go.Client[v1beta1.SuggestAlternativesRequest, v1beta1.SuggestAlternativesResponse]
+}
+
+// GetFood calls chomp.v1beta1.ChompService.GetFood.
+func (c *chompServiceClient) GetFood(ctx context.Context, req *connect_go.Request[v1beta1.GetFoodRequest]) (*connect_go.Response[v1beta1.GetFoodResponse], error) {
+	return c.getFood.CallUnary(ctx, req)
+}
+
+// ListFoods calls chomp.v1beta1.ChompService.ListFoods.
+func (c *chompServiceClient) ListFoods(ctx context.Context, req *connect_go.Request[v1beta1.ListFoodsRequest]) (*connect_go.Response[v1beta1.ListFoodsResponse], error) {
+	return c.listFoods.CallUnary(ctx, req)
+}
+
+// CalculateMeal calls chomp.v1beta1.ChompService.CalculateMeal.
+func (c *chompServiceClient) CalculateMeal(ctx context.Context, req *connect_go.Request[v1beta1.CalculateMealRequest]) (*connect_go.Response[v1beta1.CalculateMealResponse], error) {
+	return c.calculateMeal.CallUnary(ctx, req)
+}
+
+// CompareFoods calls chomp.v1beta1.ChompService.CompareFoods.
+func (c *chompServiceClient) CompareFoods(ctx context.Context, req *connect_go.Request[v1beta1.CompareFoodsRequest]) (*connect_go.Response[v1beta1.CompareFoodsResponse], error) {
+	return c.compareFoods.CallUnary(ctx, req)
+}
+
+// SuggestAlternatives calls chomp.v1beta1.ChompService.SuggestAlternatives.
+func (c *chompServiceClient) SuggestAlternatives(ctx context.Context, req *connect_go.Request[v1beta1.SuggestAlternativesRequest]) (*connect_go.Response[v1beta1.SuggestAlternativesResponse], error) {
+	return c.suggestAlternatives.CallUnary(ctx, req)
+}
+
+// ChompServiceHandler is an implementation of the chomp.v1beta1.ChompService service.
+type ChompServiceHandler interface {
+	// Get data for a branded food using the food's UPC/EAN barcode.
+	//
+	// https://app.swaggerhub.com/apis-docs/chomp/Chomp/1.0.0-oas3#/default/get_food_branded_barcode_php
+	GetFood(context.Context, *connect_go.Request[v1beta1.GetFoodRequest]) (*connect_go.Response[v1beta1.GetFoodResponse], error)
+	// Search for branded food items by name.
+	//
+	// https://app.swaggerhub.com/apis-docs/chomp/Chomp/1.0.0-oas3#/default/get_food_branded_name_php
+	ListFoods(context.Context, *connect_go.Request[v1beta1.ListFoodsRequest]) (*connect_go.Response[v1beta1.ListFoodsResponse], error)
+	// Calculate the combined nutrition of a meal made up of several branded
+	// food items, each looked up by barcode like GetFood.
+	CalculateMeal(context.Context, *connect_go.Request[v1beta1.CalculateMealRequest]) (*connect_go.Response[v1beta1.CalculateMealResponse], error)
+	// Compare several branded food items side by side, each looked up by
+	// barcode like GetFood.
+	CompareFoods(context.Context, *connect_go.Request[v1beta1.CompareFoodsRequest]) (*connect_go.Response[v1beta1.CompareFoodsResponse], error)
+	// Suggest alternatives to a branded food item that satisfy dietary
+	// constraints, ranked by similarity and nutrition grade. Candidates are
+	// found with several searches; if some of them fail, the alternatives the
+	// others found are still suggested.
+	//
+	// https://app.swaggerhub.com/apis-docs/chomp/Chomp/1.0.0-oas3#/default/get_food_branded_search_php
+	SuggestAlternatives(context.Context, *connect_go.Request[v1beta1.SuggestAlternativesRequest]) (*connect_go.Response[v1beta1.SuggestAlternativesResponse], error)
+}
+
+// NewChompServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChompServiceHandler(svc ChompServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/chomp.v1beta1.ChompService/GetFood", connect_go.NewUnaryHandler(
+		"/chomp.v1beta1.ChompService/GetFood",
+		svc.GetFood,
+		opts...,
+	))
+	mux.Handle("/chomp.v1beta1.ChompService/ListFoods", connect_go.NewUnaryHandler(
+		"/chomp.v1beta1.ChompService/ListFoods",
+		svc.ListFoods,
+		opts...,
+	))
+	mux.Handle("/chomp.v1beta1.ChompService/CalculateMeal", connect_go.NewUnaryHandler(
+		"/chomp.v1beta1.ChompService/CalculateMeal",
+		svc.CalculateMeal,
+		opts...,
+	))
+	mux.Handle("/chomp.v1beta1.ChompService/CompareFoods", connect_go.NewUnaryHandler(
+		"/chomp.v1beta1.ChompService/CompareFoods",
+		svc.CompareFoods,
+		opts...,
+	))
+	mux.Handle("/chomp.v1beta1.ChompService/SuggestAlternatives", connect_go.NewUnaryHandler(
+		"/chomp.v1beta1.ChompService/SuggestAlternatives",
+		svc.SuggestAlternatives,
+		opts...,
+	))
+	return "/chomp.v1beta1.ChompService/", mux
+}
+
+// UnimplementedChompServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChompServiceHandler struct{}
+
+func (UnimplementedChompServiceHandler) GetFood(context.Context, *connect_go.Request[v1beta1.GetFoodRequest]) (*connect_go.Response[v1beta1.GetFoodResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("chomp.v1beta1.ChompService.GetFood is not implemented"))
+}
+
+func (UnimplementedChompServiceHandler) ListFoods(context.Context, *connect_go.Request[v1beta1.ListFoodsRequest]) (*connect_go.Response[v1beta1.ListFoodsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("chomp.v1beta1.ChompService.ListFoods is not implemented"))
+}
+
+func (UnimplementedChompServiceHandler) CalculateMeal(context.Context, *connect_go.Request[v1beta1.CalculateMealRequest]) (*connect_go.Response[v1beta1.CalculateMealResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("chomp.v1beta1.ChompService.CalculateMeal is not implemented"))
+}
+
+func (UnimplementedChompServiceHandler) CompareFoods(context.Context, *connect_go.Request[v1beta1.CompareFoodsRequest]) (*connect_go.Response[v1beta1.CompareFoodsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("chomp.v1beta1.ChompService.CompareFoods is not implemented"))
+}
+
+func (UnimplementedChompServiceHandler) SuggestAlternatives(context.Context, *connect_go.Request[v1beta1.SuggestAlternativesRequest]) (*connect_go.Response[v1beta1.SuggestAlternativesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("chomp.v1beta1.ChompService.SuggestAlternatives is not implemented"))
+}
@@ -0,0 +1,2166 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: chomp/v1beta1/food.proto
+
+package chompv1beta1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Food struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EAN/UPC barcode
+	Barcode string `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	// Item name as provided by brand owner or as shown on packaging
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The brand name that owns this item
+	Brand string `protobuf:"bytes,3,opt,name=brand,proto3" json:"brand,omitempty"`
+	// This food item's ingredients from greatest quantity to least
+	Ingredients string `protobuf:"bytes,4,opt,name=ingredients,proto3" json:"ingredients,omitempty"`
+	// An object containing basic packaging information about this item
+	Package *Package `protobuf:"bytes,5,opt,name=package,proto3" json:"package,omitempty"`
+	// An object containing serving information for this item
+	Serving *Serving `protobuf:"bytes,6,opt,name=serving,proto3" json:"serving,omitempty"`
+	// An array of categories for this item
+	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	// An array containing nutrient information objects for this food item
+	Nutrients []*Nutrient `protobuf:"bytes,8,rep,name=nutrients,proto3" json:"nutrients,omitempty"`
+	// This item's compatibility grades for certain supported diets
+	DietLabels *DietLabels `protobuf:"bytes,9,opt,name=diet_labels,json=dietLabels,proto3" json:"diet_labels,omitempty"`
+	// An array of ingredient objects that were flagged while grading this item
+	// for compatibility with each diet
+	DietFlags []*DietFlag `protobuf:"bytes,10,rep,name=diet_flags,json=dietFlags,proto3" json:"diet_flags,omitempty"`
+	// A object containing a collection of photos of this item's packaging
+	PackagingPhotos *PackagingPhotos `protobuf:"bytes,11,opt,name=packaging_photos,json=packagingPhotos,proto3" json:"packaging_photos,omitempty"`
+	// An array of ingredients in this item that may cause allergic reactions in
+	// people
+	Allergens []string `protobuf:"bytes,12,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	// An array of brands we have associated with this item. Some items are sold
+	// by more than 1 brand.
+	BrandList []string `protobuf:"bytes,13,rep,name=brand_list,json=brandList,proto3" json:"brand_list,omitempty"`
+	// An array of countries where this item is sold
+	Countries []string `protobuf:"bytes,14,rep,name=countries,proto3" json:"countries,omitempty"`
+	// An object containing additional information on the countries where this
+	// item is found
+	CountryDetails *CountryDetails `protobuf:"bytes,15,opt,name=country_details,json=countryDetails,proto3" json:"country_details,omitempty"`
+	// An array of ingredients made from palm oil
+	PalmOilIngredients []string `protobuf:"bytes,16,rep,name=palm_oil_ingredients,json=palmOilIngredients,proto3" json:"palm_oil_ingredients,omitempty"`
+	// An array of this item's ingredients
+	IngredientList []string `protobuf:"bytes,17,rep,name=ingredient_list,json=ingredientList,proto3" json:"ingredient_list,omitempty"`
+	// A boolean indicating if we have English ingredients for this item
+	HasEnglishIngredients bool `protobuf:"varint,18,opt,name=has_english_ingredients,json=hasEnglishIngredients,proto3" json:"has_english_ingredients,omitempty"`
+	// An array of minerals that this item contains
+	Minerals []string `protobuf:"bytes,19,rep,name=minerals,proto3" json:"minerals,omitempty"`
+	// An array of trace ingredients that may be found in this item
+	Traces []string `protobuf:"bytes,20,rep,name=traces,proto3" json:"traces,omitempty"`
+	// An array of vitamins that are found in this item
+	Vitamins []string `protobuf:"bytes,21,rep,name=vitamins,proto3" json:"vitamins,omitempty"`
+	// A description of this item
+	Description string `protobuf:"bytes,22,opt,name=description,proto3" json:"description,omitempty"`
+	// An array of keywords that can be used to describe this item
+	Keywords []string `protobuf:"bytes,23,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// This item's Nutri-Score, computed by the proxy from its nutrients and
+	// categories. Not set if a required nutrient (energy, sugars, saturated fat
+	// or sodium) is missing. Chomp doesn't report the share of fruits,
+	// vegetables and nuts, so that component always scores 0 points, and the
+	// grade may be worse than the one printed on the package.
+	NutritionGrade *NutritionGrade `protobuf:"bytes,24,opt,name=nutrition_grade,json=nutritionGrade,proto3" json:"nutrition_grade,omitempty"`
+	// This item's ingredients parsed by the proxy from the ingredients text,
+	// from greatest quantity to least, with sub-ingredients nested under the
+	// ingredient they make up. Allergen statements like "Contains: milk" are
+	// left out; those allergens are in allergens and allergen_findings.
+	IngredientTree []*Ingredient `protobuf:"bytes,25,rep,name=ingredient_tree,json=ingredientTree,proto3" json:"ingredient_tree,omitempty"`
+	// The allergens in this item, combining those reported by Chomp in
+	// allergens and traces with those the proxy detected in the ingredients.
+	// Detected allergens are also merged into allergens and traces.
+	AllergenFindings []*AllergenFinding `protobuf:"bytes,26,rep,name=allergen_findings,json=allergenFindings,proto3" json:"allergen_findings,omitempty"`
+	// The additives the proxy recognized in the ingredients, by E-number or
+	// by name, in order of their first mention
+	Additives []*Additive `protobuf:"bytes,27,rep,name=additives,proto3" json:"additives,omitempty"`
+}
+
+func (x *Food) Reset() {
+	*x = Food{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Food) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Food) ProtoMessage() {}
+
+func (x *Food) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Food.ProtoReflect.Descriptor instead.
+func (*Food) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Food) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Food) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Food) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Food) GetIngredients() string {
+	if x != nil {
+		return x.Ingredients
+	}
+	return ""
+}
+
+func (x *Food) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *Food) GetServing() *Serving {
+	if x != nil {
+		return x.Serving
+	}
+	return nil
+}
+
+func (x *Food) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Food) GetNutrients() []*Nutrient {
+	if x != nil {
+		return x.Nutrients
+	}
+	return nil
+}
+
+func (x *Food) GetDietLabels() *DietLabels {
+	if x != nil {
+		return x.DietLabels
+	}
+	return nil
+}
+
+func (x *Food) GetDietFlags() []*DietFlag {
+	if x != nil {
+		return x.DietFlags
+	}
+	return nil
+}
+
+func (x *Food) GetPackagingPhotos() *PackagingPhotos {
+	if x != nil {
+		return x.PackagingPhotos
+	}
+	return nil
+}
+
+func (x *Food) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *Food) GetBrandList() []string {
+	if x != nil {
+		return x.BrandList
+	}
+	return nil
+}
+
+func (x *Food) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *Food) GetCountryDetails() *CountryDetails {
+	if x != nil {
+		return x.CountryDetails
+	}
+	return nil
+}
+
+func (x *Food) GetPalmOilIngredients() []string {
+	if x != nil {
+		return x.PalmOilIngredients
+	}
+	return nil
+}
+
+func (x *Food) GetIngredientList() []string {
+	if x != nil {
+		return x.IngredientList
+	}
+	return nil
+}
+
+func (x *Food) GetHasEnglishIngredients() bool {
+	if x != nil {
+		return x.HasEnglishIngredients
+	}
+	return false
+}
+
+func (x *Food) GetMinerals() []string {
+	if x != nil {
+		return x.Minerals
+	}
+	return nil
+}
+
+func (x *Food) GetTraces() []string {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
+func (x *Food) GetVitamins() []string {
+	if x != nil {
+		return x.Vitamins
+	}
+	return nil
+}
+
+func (x *Food) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Food) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *Food) GetNutritionGrade() *NutritionGrade {
+	if x != nil {
+		return x.NutritionGrade
+	}
+	return nil
+}
+
+func (x *Food) GetIngredientTree() []*Ingredient {
+	if x != nil {
+		return x.IngredientTree
+	}
+	return nil
+}
+
+func (x *Food) GetAllergenFindings() []*AllergenFinding {
+	if x != nil {
+		return x.AllergenFindings
+	}
+	return nil
+}
+
+func (x *Food) GetAdditives() []*Additive {
+	if x != nil {
+		return x.Additives
+	}
+	return nil
+}
+
+// A food additive
+type Additive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// E-number, e.g. "E621"
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Common name, e.g. "Monosodium glutamate"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Main technological function, e.g. "flavour enhancer" or "preservative"
+	FunctionClass string `protobuf:"bytes,3,opt,name=function_class,json=functionClass,proto3" json:"function_class,omitempty"`
+	// Health concerns raised about the additive. Empty if there are none.
+	RiskNotes string `protobuf:"bytes,4,opt,name=risk_notes,json=riskNotes,proto3" json:"risk_notes,omitempty"`
+	// The E-numbers and names that indicated the additive, as they appear in
+	// the ingredients
+	MatchedTerms []string `protobuf:"bytes,5,rep,name=matched_terms,json=matchedTerms,proto3" json:"matched_terms,omitempty"`
+}
+
+func (x *Additive) Reset() {
+	*x = Additive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Additive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Additive) ProtoMessage() {}
+
+func (x *Additive) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Additive.ProtoReflect.Descriptor instead.
+func (*Additive) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Additive) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Additive) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Additive) GetFunctionClass() string {
+	if x != nil {
+		return x.FunctionClass
+	}
+	return ""
+}
+
+func (x *Additive) GetRiskNotes() string {
+	if x != nil {
+		return x.RiskNotes
+	}
+	return ""
+}
+
+func (x *Additive) GetMatchedTerms() []string {
+	if x != nil {
+		return x.MatchedTerms
+	}
+	return nil
+}
+
+// An allergen found in an item
+type AllergenFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Allergen name. This is a canonical name like "milk" or "tree nuts", unless
+	// it was only reported by Chomp under a name the proxy doesn't recognize.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether Chomp reported the allergen
+	Provided bool `protobuf:"varint,2,opt,name=provided,proto3" json:"provided,omitempty"`
+	// Whether the proxy detected the allergen in the item's ingredients, or
+	// it's implied by an allergen Chomp reported (e.g. gluten by wheat)
+	Inferred bool `protobuf:"varint,3,opt,name=inferred,proto3" json:"inferred,omitempty"`
+	// Whether the item only may contain traces of the allergen, as opposed to
+	// containing it as an ingredient
+	Trace bool `protobuf:"varint,4,opt,name=trace,proto3" json:"trace,omitempty"`
+	// The ingredient terms that indicated the allergen (e.g. "whey" for milk)
+	MatchedTerms []string `protobuf:"bytes,5,rep,name=matched_terms,json=matchedTerms,proto3" json:"matched_terms,omitempty"`
+	// The regulations that name this a major allergen ("fda" or "eu")
+	Regulations []string `protobuf:"bytes,6,rep,name=regulations,proto3" json:"regulations,omitempty"`
+}
+
+func (x *AllergenFinding) Reset() {
+	*x = AllergenFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllergenFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllergenFinding) ProtoMessage() {}
+
+func (x *AllergenFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllergenFinding.ProtoReflect.Descriptor instead.
+func (*AllergenFinding) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AllergenFinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AllergenFinding) GetProvided() bool {
+	if x != nil {
+		return x.Provided
+	}
+	return false
+}
+
+func (x *AllergenFinding) GetInferred() bool {
+	if x != nil {
+		return x.Inferred
+	}
+	return false
+}
+
+func (x *AllergenFinding) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
+func (x *AllergenFinding) GetMatchedTerms() []string {
+	if x != nil {
+		return x.MatchedTerms
+	}
+	return nil
+}
+
+func (x *AllergenFinding) GetRegulations() []string {
+	if x != nil {
+		return x.Regulations
+	}
+	return nil
+}
+
+// An ingredient parsed from an item's ingredients text
+type Ingredient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ingredient name as written on the label
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The share of the item this ingredient makes up, if stated on the label.
+	// Only set if percent_available is true.
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Whether the label states the share of the item this ingredient makes up
+	PercentAvailable bool `protobuf:"varint,3,opt,name=percent_available,json=percentAvailable,proto3" json:"percent_available,omitempty"`
+	// The most this ingredient can make up of the item, for ingredients listed
+	// after a marker like "contains 2% or less of". Zero if there's no marker.
+	MaxPercent float64 `protobuf:"fixed64,4,opt,name=max_percent,json=maxPercent,proto3" json:"max_percent,omitempty"`
+	// The ingredients this ingredient is made of, e.g. "sugar" and "cocoa
+	// butter" for "chocolate (sugar, cocoa butter)"
+	Ingredients []*Ingredient `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Ingredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ingredient) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Ingredient) GetPercentAvailable() bool {
+	if x != nil {
+		return x.PercentAvailable
+	}
+	return false
+}
+
+func (x *Ingredient) GetMaxPercent() float64 {
+	if x != nil {
+		return x.MaxPercent
+	}
+	return 0
+}
+
+func (x *Ingredient) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// An object containing basic packaging information about this item
+type Package struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Package quantity
+	Quantity int32 `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Package size
+	Size string `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *Package) Reset() {
+	*x = Package{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Package) ProtoMessage() {}
+
+func (x *Package) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Package) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Package) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+// An object containing serving information for this item
+type Serving struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serving size
+	Size string `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
+	// Measurement unit for each serving (e.g. if measure is 3 tsp, the unit is tsp)
+	MeasurementUnit string `protobuf:"bytes,2,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	// Serving size description
+	SizeFulltext string `protobuf:"bytes,3,opt,name=size_fulltext,json=sizeFulltext,proto3" json:"size_fulltext,omitempty"`
+}
+
+func (x *Serving) Reset() {
+	*x = Serving{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Serving) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Serving) ProtoMessage() {}
+
+func (x *Serving) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Serving.ProtoReflect.Descriptor instead.
+func (*Serving) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Serving) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Serving) GetMeasurementUnit() string {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return ""
+}
+
+func (x *Serving) GetSizeFulltext() string {
+	if x != nil {
+		return x.SizeFulltext
+	}
+	return ""
+}
+
+// An object containing information for a specific nutrient found in this food
+// item
+type Nutrient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nutrient name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Amount of the nutrient per 100g of food, truncated to a whole number. It's
+	// always in the unit Chomp reports, which differs from measurement_unit if
+	// a unit system converts it.
+	//
+	// Deprecated: use per_100g_value, which preserves fractional amounts.
+	//
+	// Deprecated: Do not use.
+	Per_100G int32 `protobuf:"varint,2,opt,name=per_100g,json=per100g,proto3" json:"per_100g,omitempty"`
+	// The unit used for the measure of this nutrient
+	MeasurementUnit string `protobuf:"bytes,3,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	// Nutrient rank
+	Rank int32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Number of observations on which the value is based
+	DataPoints int32 `protobuf:"varint,5,opt,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	// Description of the nutrient source
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Amount of the nutrient per 100g of food
+	Per_100GValue float64 `protobuf:"fixed64,7,opt,name=per_100g_value,json=per100gValue,proto3" json:"per_100g_value,omitempty"`
+	// Amount of the nutrient in a single serving, derived from per_100g_value
+	// and the serving size. Only set if per_serving_available is true.
+	PerServing float64 `protobuf:"fixed64,8,opt,name=per_serving,json=perServing,proto3" json:"per_serving,omitempty"`
+	// Whether the serving size could be converted to grams, which is required
+	// to compute per_serving
+	PerServingAvailable bool `protobuf:"varint,9,opt,name=per_serving_available,json=perServingAvailable,proto3" json:"per_serving_available,omitempty"`
+	// Amount of the nutrient in the whole package, derived from per_100g_value
+	// and the package size. Only set if per_package_available is true.
+	PerPackage float64 `protobuf:"fixed64,10,opt,name=per_package,json=perPackage,proto3" json:"per_package,omitempty"`
+	// Whether the package size could be converted to grams, which is required
+	// to compute per_package
+	PerPackageAvailable bool `protobuf:"varint,11,opt,name=per_package_available,json=perPackageAvailable,proto3" json:"per_package_available,omitempty"`
+	// How much of the reference daily intake this nutrient provides. Not set if
+	// the selected reference profile has no reference for this nutrient.
+	DailyValue *DailyValue `protobuf:"bytes,12,opt,name=daily_value,json=dailyValue,proto3" json:"daily_value,omitempty"`
+}
+
+func (x *Nutrient) Reset() {
+	*x = Nutrient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nutrient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nutrient) ProtoMessage() {}
+
+func (x *Nutrient) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nutrient.ProtoReflect.Descriptor instead.
+func (*Nutrient) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Nutrient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Nutrient) GetPer_100G() int32 {
+	if x != nil {
+		return x.Per_100G
+	}
+	return 0
+}
+
+func (x *Nutrient) GetMeasurementUnit() string {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return ""
+}
+
+func (x *Nutrient) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Nutrient) GetDataPoints() int32 {
+	if x != nil {
+		return x.DataPoints
+	}
+	return 0
+}
+
+func (x *Nutrient) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Nutrient) GetPer_100GValue() float64 {
+	if x != nil {
+		return x.Per_100GValue
+	}
+	return 0
+}
+
+func (x *Nutrient) GetPerServing() float64 {
+	if x != nil {
+		return x.PerServing
+	}
+	return 0
+}
+
+func (x *Nutrient) GetPerServingAvailable() bool {
+	if x != nil {
+		return x.PerServingAvailable
+	}
+	return false
+}
+
+func (x *Nutrient) GetPerPackage() float64 {
+	if x != nil {
+		return x.PerPackage
+	}
+	return 0
+}
+
+func (x *Nutrient) GetPerPackageAvailable() bool {
+	if x != nil {
+		return x.PerPackageAvailable
+	}
+	return false
+}
+
+func (x *Nutrient) GetDailyValue() *DailyValue {
+	if x != nil {
+		return x.DailyValue
+	}
+	return nil
+}
+
+// The share of a reference daily intake that a nutrient provides
+type DailyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the reference profile (e.g. "fda" or "eu_ri")
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// The reference daily intake of the nutrient
+	ReferenceAmount float64 `protobuf:"fixed64,2,opt,name=reference_amount,json=referenceAmount,proto3" json:"reference_amount,omitempty"`
+	// The unit of reference_amount
+	ReferenceUnit string `protobuf:"bytes,3,opt,name=reference_unit,json=referenceUnit,proto3" json:"reference_unit,omitempty"`
+	// Percentage of the reference daily intake provided by 100g of food
+	Per_100GPercent float64 `protobuf:"fixed64,4,opt,name=per_100g_percent,json=per100gPercent,proto3" json:"per_100g_percent,omitempty"`
+	// Percentage of the reference daily intake provided by a single serving.
+	// Only set if the nutrient's per_serving_available is true.
+	PerServingPercent float64 `protobuf:"fixed64,5,opt,name=per_serving_percent,json=perServingPercent,proto3" json:"per_serving_percent,omitempty"`
+}
+
+func (x *DailyValue) Reset() {
+	*x = DailyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyValue) ProtoMessage() {}
+
+func (x *DailyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyValue.ProtoReflect.Descriptor instead.
+func (*DailyValue) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DailyValue) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *DailyValue) GetReferenceAmount() float64 {
+	if x != nil {
+		return x.ReferenceAmount
+	}
+	return 0
+}
+
+func (x *DailyValue) GetReferenceUnit() string {
+	if x != nil {
+		return x.ReferenceUnit
+	}
+	return ""
+}
+
+func (x *DailyValue) GetPer_100GPercent() float64 {
+	if x != nil {
+		return x.Per_100GPercent
+	}
+	return 0
+}
+
+func (x *DailyValue) GetPerServingPercent() float64 {
+	if x != nil {
+		return x.PerServingPercent
+	}
+	return 0
+}
+
+// A Nutri-Score style grade of how healthy a food is
+type NutritionGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Letter grade from A (healthiest) to E (least healthy)
+	Grade string `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	// The score the grade is based on. Lower is healthier.
+	Score int32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Points from energy, sugars, saturated fat and sodium
+	NegativePoints int32 `protobuf:"varint,3,opt,name=negative_points,json=negativePoints,proto3" json:"negative_points,omitempty"`
+	// Points from fiber and protein. Fruits, vegetables and nuts would count
+	// too, but their share isn't available, so they never add points.
+	PositivePoints int32 `protobuf:"varint,4,opt,name=positive_points,json=positivePoints,proto3" json:"positive_points,omitempty"`
+	// The variant of the algorithm used (general, beverage, cheese, fat or
+	// water), chosen from the item's categories
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	// The contribution of each nutrient to the score
+	Components []*NutritionGradeComponent `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"`
+	// A sentence explaining which nutrients drove the score
+	Explanation string `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *NutritionGrade) Reset() {
+	*x = NutritionGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutritionGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionGrade) ProtoMessage() {}
+
+func (x *NutritionGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionGrade.ProtoReflect.Descriptor instead.
+func (*NutritionGrade) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NutritionGrade) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *NutritionGrade) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NutritionGrade) GetNegativePoints() int32 {
+	if x != nil {
+		return x.NegativePoints
+	}
+	return 0
+}
+
+func (x *NutritionGrade) GetPositivePoints() int32 {
+	if x != nil {
+		return x.PositivePoints
+	}
+	return 0
+}
+
+func (x *NutritionGrade) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NutritionGrade) GetComponents() []*NutritionGradeComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *NutritionGrade) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// A single nutrient's contribution to a NutritionGrade
+type NutritionGradeComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the component (e.g. "sugars")
+	Nutrient string `protobuf:"bytes,1,opt,name=nutrient,proto3" json:"nutrient,omitempty"`
+	// Amount of the nutrient per 100g (or 100ml for beverages)
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The unit of value
+	MeasurementUnit string `protobuf:"bytes,3,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	// Points awarded for this component
+	Points int32 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	// The most points this component can be awarded
+	MaxPoints int32 `protobuf:"varint,5,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	// Whether the points improve the score rather than worsen it
+	Positive bool `protobuf:"varint,6,opt,name=positive,proto3" json:"positive,omitempty"`
+	// Whether the points were counted towards the score. Protein isn't counted
+	// for foods with many negative points.
+	Counted bool `protobuf:"varint,7,opt,name=counted,proto3" json:"counted,omitempty"`
+}
+
+func (x *NutritionGradeComponent) Reset() {
+	*x = NutritionGradeComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutritionGradeComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionGradeComponent) ProtoMessage() {}
+
+func (x *NutritionGradeComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionGradeComponent.ProtoReflect.Descriptor instead.
+func (*NutritionGradeComponent) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NutritionGradeComponent) GetNutrient() string {
+	if x != nil {
+		return x.Nutrient
+	}
+	return ""
+}
+
+func (x *NutritionGradeComponent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *NutritionGradeComponent) GetMeasurementUnit() string {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return ""
+}
+
+func (x *NutritionGradeComponent) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *NutritionGradeComponent) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *NutritionGradeComponent) GetPositive() bool {
+	if x != nil {
+		return x.Positive
+	}
+	return false
+}
+
+func (x *NutritionGradeComponent) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+// An object containing compatibility grades for certain supported diets
+type DietLabels struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An object containing information on this item's compatibility with the
+	// Vegan diets
+	Vegan *DietLabel `protobuf:"bytes,1,opt,name=vegan,proto3" json:"vegan,omitempty"`
+	// An object containing information on this item's compatibility with
+	// Vegetarian diets
+	Vegetarian *DietLabel `protobuf:"bytes,2,opt,name=vegetarian,proto3" json:"vegetarian,omitempty"`
+	// An object containing information on this item's compatibility with Gluten
+	// Free diets
+	GlutenFree *DietLabel `protobuf:"bytes,3,opt,name=gluten_free,json=glutenFree,proto3" json:"gluten_free,omitempty"`
+}
+
+func (x *DietLabels) Reset() {
+	*x = DietLabels{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DietLabels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DietLabels) ProtoMessage() {}
+
+func (x *DietLabels) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DietLabels.ProtoReflect.Descriptor instead.
+func (*DietLabels) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DietLabels) GetVegan() *DietLabel {
+	if x != nil {
+		return x.Vegan
+	}
+	return nil
+}
+
+func (x *DietLabels) GetVegetarian() *DietLabel {
+	if x != nil {
+		return x.Vegetarian
+	}
+	return nil
+}
+
+func (x *DietLabels) GetGlutenFree() *DietLabel {
+	if x != nil {
+		return x.GlutenFree
+	}
+	return nil
+}
+
+// An object containing this item's compatibility grades for a particular diet
+type DietLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsCompatible          bool   `protobuf:"varint,2,opt,name=is_compatible,json=isCompatible,proto3" json:"is_compatible,omitempty"`
+	CompatibilityLevel    int32  `protobuf:"varint,3,opt,name=compatibility_level,json=compatibilityLevel,proto3" json:"compatibility_level,omitempty"`
+	Confidence            int32  `protobuf:"varint,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	ConfidenceDescription string `protobuf:"bytes,5,opt,name=confidence_description,json=confidenceDescription,proto3" json:"confidence_description,omitempty"`
+}
+
+func (x *DietLabel) Reset() {
+	*x = DietLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DietLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DietLabel) ProtoMessage() {}
+
+func (x *DietLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DietLabel.ProtoReflect.Descriptor instead.
+func (*DietLabel) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DietLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DietLabel) GetIsCompatible() bool {
+	if x != nil {
+		return x.IsCompatible
+	}
+	return false
+}
+
+func (x *DietLabel) GetCompatibilityLevel() int32 {
+	if x != nil {
+		return x.CompatibilityLevel
+	}
+	return 0
+}
+
+func (x *DietLabel) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DietLabel) GetConfidenceDescription() string {
+	if x != nil {
+		return x.ConfidenceDescription
+	}
+	return ""
+}
+
+// An object containing information on an individual ingredient that was flagged
+// as potentially not being compatible with a certain diet
+type DietFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ingredient name
+	Ingredient string `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	// Description of the ingredient
+	IngredientDescription string `protobuf:"bytes,2,opt,name=ingredient_description,json=ingredientDescription,proto3" json:"ingredient_description,omitempty"`
+	// Name of the diet with which this ingredient may not be compatible
+	DietLabel string `protobuf:"bytes,3,opt,name=diet_label,json=dietLabel,proto3" json:"diet_label,omitempty"`
+	// A description of if we believe this ingredient is compatible with the diet
+	IsCompatible string `protobuf:"bytes,4,opt,name=is_compatible,json=isCompatible,proto3" json:"is_compatible,omitempty"`
+	// A numeric representation of if we believe this ingredient is compatible
+	// with the diet. Higher values indicate more compatibility
+	CompatibilityLevel int32 `protobuf:"varint,5,opt,name=compatibility_level,json=compatibilityLevel,proto3" json:"compatibility_level,omitempty"`
+	// A description of how we graded this ingredient for compatibility with the
+	// diet
+	CompatibilityDescription string `protobuf:"bytes,6,opt,name=compatibility_description,json=compatibilityDescription,proto3" json:"compatibility_description,omitempty"`
+	// Boolean representing if the ingredient is a known allergen
+	IsAllergen bool `protobuf:"varint,7,opt,name=is_allergen,json=isAllergen,proto3" json:"is_allergen,omitempty"`
+}
+
+func (x *DietFlag) Reset() {
+	*x = DietFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DietFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DietFlag) ProtoMessage() {}
+
+func (x *DietFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DietFlag.ProtoReflect.Descriptor instead.
+func (*DietFlag) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DietFlag) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+func (x *DietFlag) GetIngredientDescription() string {
+	if x != nil {
+		return x.IngredientDescription
+	}
+	return ""
+}
+
+func (x *DietFlag) GetDietLabel() string {
+	if x != nil {
+		return x.DietLabel
+	}
+	return ""
+}
+
+func (x *DietFlag) GetIsCompatible() string {
+	if x != nil {
+		return x.IsCompatible
+	}
+	return ""
+}
+
+func (x *DietFlag) GetCompatibilityLevel() int32 {
+	if x != nil {
+		return x.CompatibilityLevel
+	}
+	return 0
+}
+
+func (x *DietFlag) GetCompatibilityDescription() string {
+	if x != nil {
+		return x.CompatibilityDescription
+	}
+	return ""
+}
+
+func (x *DietFlag) GetIsAllergen() bool {
+	if x != nil {
+		return x.IsAllergen
+	}
+	return false
+}
+
+// A object containing a collection of photos of this item's packaging
+type PackagingPhotos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An object containing photos of the front of this item's packaging
+	Front *Photo `protobuf:"bytes,1,opt,name=front,proto3" json:"front,omitempty"`
+	// An object containing photos of this item's nutrition label
+	Nutrition *Photo `protobuf:"bytes,2,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	// An object containing photos of the ingredients on this item's packaging
+	Ingredients *Photo `protobuf:"bytes,3,opt,name=ingredients,proto3" json:"ingredients,omitempty"`
+}
+
+func (x *PackagingPhotos) Reset() {
+	*x = PackagingPhotos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackagingPhotos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagingPhotos) ProtoMessage() {}
+
+func (x *PackagingPhotos) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagingPhotos.ProtoReflect.Descriptor instead.
+func (*PackagingPhotos) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PackagingPhotos) GetFront() *Photo {
+	if x != nil {
+		return x.Front
+	}
+	return nil
+}
+
+func (x *PackagingPhotos) GetNutrition() *Photo {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+func (x *PackagingPhotos) GetIngredients() *Photo {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// An object containing photos of the front of this item's packaging
+type Photo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Small photo of the front of this item's packaging
+	Small string `protobuf:"bytes,1,opt,name=small,proto3" json:"small,omitempty"`
+	// Thumbnail photo of the front of this item's packaging
+	Thumb string `protobuf:"bytes,2,opt,name=thumb,proto3" json:"thumb,omitempty"`
+	// Full-sized photo of the front of this item's packaging
+	Display string `protobuf:"bytes,3,opt,name=display,proto3" json:"display,omitempty"`
+}
+
+func (x *Photo) Reset() {
+	*x = Photo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Photo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Photo) ProtoMessage() {}
+
+func (x *Photo) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Photo.ProtoReflect.Descriptor instead.
+func (*Photo) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Photo) GetSmall() string {
+	if x != nil {
+		return x.Small
+	}
+	return ""
+}
+
+func (x *Photo) GetThumb() string {
+	if x != nil {
+		return x.Thumb
+	}
+	return ""
+}
+
+func (x *Photo) GetDisplay() string {
+	if x != nil {
+		return x.Display
+	}
+	return ""
+}
+
+// An object containing additional information on the countries where this item
+// is found
+type CountryDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of countries where English is the country's primary language
+	EnglishSpeaking int32 `protobuf:"varint,1,opt,name=english_speaking,json=englishSpeaking,proto3" json:"english_speaking,omitempty"`
+	// The number of countries where English is not the country's primary language
+	NonEnglishSpeaking int32 `protobuf:"varint,2,opt,name=non_english_speaking,json=nonEnglishSpeaking,proto3" json:"non_english_speaking,omitempty"`
+}
+
+func (x *CountryDetails) Reset() {
+	*x = CountryDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chomp_v1beta1_food_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountryDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryDetails) ProtoMessage() {}
+
+func (x *CountryDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_chomp_v1beta1_food_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryDetails.ProtoReflect.Descriptor instead.
+func (*CountryDetails) Descriptor() ([]byte, []int) {
+	return file_chomp_v1beta1_food_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CountryDetails) GetEnglishSpeaking() int32 {
+	if x != nil {
+		return x.EnglishSpeaking
+	}
+	return 0
+}
+
+func (x *CountryDetails) GetNonEnglishSpeaking() int32 {
+	if x != nil {
+		return x.NonEnglishSpeaking
+	}
+	return 0
+}
+
+var File_chomp_v1beta1_food_proto protoreflect.FileDescriptor
+
+var file_chomp_v1beta1_food_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x66, 0x6f, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x63, 0x68, 0x6f, 0x6d,
+	0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x22, 0xba, 0x09, 0x0a, 0x04, 0x46, 0x6f,
+	0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x6d,
+	0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
+	0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09,
+	0x6e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x36, 0x0a, 0x0a, 0x64, 0x69, 0x65, 0x74, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x09, 0x64, 0x69,
+	0x65, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x73, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a,
+	0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x6c, 0x6d, 0x5f, 0x6f, 0x69,
+	0x6c, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x6c, 0x6d, 0x4f, 0x69, 0x6c, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x68, 0x61, 0x73, 0x45, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x69, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x0e,
+	0x6e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x42,
+	0x0a, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x65,
+	0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x0e, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72,
+	0x65, 0x65, 0x12, 0x4b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x5f, 0x66,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x6c,
+	0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x35, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x76, 0x65, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x69, 0x73, 0x6b, 0x4e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3b,
+	0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x07, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x6d, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x69, 0x7a, 0x65, 0x46, 0x75, 0x6c,
+	0x6c, 0x74, 0x65, 0x78, 0x74, 0x22, 0xcb, 0x03, 0x0a, 0x08, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x5f, 0x31, 0x30,
+	0x30, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x31, 0x30, 0x30, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x5f, 0x31,
+	0x30, 0x30, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x70, 0x65, 0x72, 0x31, 0x30, 0x30, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x32,
+	0x0a, 0x15, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x70, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x70, 0x65, 0x72, 0x5f, 0x31, 0x30, 0x30, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x31, 0x30, 0x30,
+	0x67, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x0e, 0x4e, 0x75, 0x74,
+	0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x6d,
+	0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xe3, 0x01, 0x0a, 0x17, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x65, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x65, 0x67, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x05, 0x76,
+	0x65, 0x67, 0x61, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x76, 0x65, 0x67, 0x65, 0x74, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x0a, 0x76, 0x65, 0x67, 0x65, 0x74, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x12, 0x39,
+	0x0a, 0x0b, 0x67, 0x6c, 0x75, 0x74, 0x65, 0x6e, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0a, 0x67,
+	0x6c, 0x75, 0x74, 0x65, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x09, 0x44, 0x69,
+	0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65,
+	0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x35, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x02, 0x0a, 0x08, 0x44, 0x69, 0x65,
+	0x74, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x69, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x69, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65,
+	0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x3b, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x22,
+	0xa9, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x68, 0x6f,
+	0x74, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x12,
+	0x32, 0x0a, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x6f, 0x6d, 0x70,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x0b,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x05, 0x50,
+	0x68, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68,
+	0x75, 0x6d, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x68, 0x75, 0x6d, 0x62,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x6d, 0x0a, 0x0e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x53,
+	0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6e, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_chomp_v1beta1_food_proto_rawDescOnce sync.Once
+	file_chomp_v1beta1_food_proto_rawDescData = file_chomp_v1beta1_food_proto_rawDesc
+)
+
+func file_chomp_v1beta1_food_proto_rawDescGZIP() []byte {
+	file_chomp_v1beta1_food_proto_rawDescOnce.Do(func() {
+		file_chomp_v1beta1_food_proto_rawDescData = protoimpl.X.CompressGZIP(file_chomp_v1beta1_food_proto_rawDescData)
+	})
+	return file_chomp_v1beta1_food_proto_rawDescData
+}
+
+var file_chomp_v1beta1_food_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_chomp_v1beta1_food_proto_goTypes = []interface{}{
+	(*Food)(nil),                    // 0: chomp.v1beta1.Food
+	(*Additive)(nil),                // 1: chomp.v1beta1.Additive
+	(*AllergenFinding)(nil),         // 2: chomp.v1beta1.AllergenFinding
+	(*Ingredient)(nil),              // 3: chomp.v1beta1.Ingredient
+	(*Package)(nil),                 // 4: chomp.v1beta1.Package
+	(*Serving)(nil),                 // 5: chomp.v1beta1.Serving
+	(*Nutrient)(nil),                // 6: chomp.v1beta1.Nutrient
+	(*DailyValue)(nil),              // 7: chomp.v1beta1.DailyValue
+	(*NutritionGrade)(nil),          // 8: chomp.v1beta1.NutritionGrade
+	(*NutritionGradeComponent)(nil), // 9: chomp.v1beta1.NutritionGradeComponent
+	(*DietLabels)(nil),              // 10: chomp.v1beta1.DietLabels
+	(*DietLabel)(nil),               // 11: chomp.v1beta1.DietLabel
+	(*DietFlag)(nil),                // 12: chomp.v1beta1.DietFlag
+	(*PackagingPhotos)(nil),         // 13: chomp.v1beta1.PackagingPhotos
+	(*Photo)(nil),                   // 14: chomp.v1beta1.Photo
+	(*CountryDetails)(nil),          // 15: chomp.v1beta1.CountryDetails
+}
+var file_chomp_v1beta1_food_proto_depIdxs = []int32{
+	4,  // 0: chomp.v1beta1.Food.package:type_name -> chomp.v1beta1.Package
+	5,  // 1: chomp.v1beta1.Food.serving:type_name -> chomp.v1beta1.Serving
+	6,  // 2: chomp.v1beta1.Food.nutrients:type_name -> chomp.v1beta1.Nutrient
+	10, // 3: chomp.v1beta1.Food.diet_labels:type_name -> chomp.v1beta1.DietLabels
+	12, // 4: chomp.v1beta1.Food.diet_flags:type_name -> chomp.v1beta1.DietFlag
+	13, // 5: chomp.v1beta1.Food.packaging_photos:type_name -> chomp.v1beta1.PackagingPhotos
+	15, // 6: chomp.v1beta1.Food.country_details:type_name -> chomp.v1beta1.CountryDetails
+	8,  // 7: chomp.v1beta1.Food.nutrition_grade:type_name -> chomp.v1beta1.NutritionGrade
+	3,  // 8: chomp.v1beta1.Food.ingredient_tree:type_name -> chomp.v1beta1.Ingredient
+	2,  // 9: chomp.v1beta1.Food.allergen_findings:type_name -> chomp.v1beta1.AllergenFinding
+	1,  // 10: chomp.v1beta1.Food.additives:type_name -> chomp.v1beta1.Additive
+	3,  // 11: chomp.v1beta1.Ingredient.ingredients:type_name -> chomp.v1beta1.Ingredient
+	7,  // 12: chomp.v1beta1.Nutrient.daily_value:type_name -> chomp.v1beta1.DailyValue
+	9,  // 13: chomp.v1beta1.NutritionGrade.components:type_name -> chomp.v1beta1.NutritionGradeComponent
+	11, // 14: chomp.v1beta1.DietLabels.vegan:type_name -> chomp.v1beta1.DietLabel
+	11, // 15: chomp.v1beta1.DietLabels.vegetarian:type_name -> chomp.v1beta1.DietLabel
+	11, // 16: chomp.v1beta1.DietLabels.gluten_free:type_name -> chomp.v1beta1.DietLabel
+	14, // 17: chomp.v1beta1.PackagingPhotos.front:type_name -> chomp.v1beta1.Photo
+	14, // 18: chomp.v1beta1.PackagingPhotos.nutrition:type_name -> chomp.v1beta1.Photo
+	14, // 19: chomp.v1beta1.PackagingPhotos.ingredients:type_name -> chomp.v1beta1.Photo
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_chomp_v1beta1_food_proto_init() }
+func file_chomp_v1beta1_food_proto_init() {
+	if File_chomp_v1beta1_food_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chomp_v1beta1_food_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Food); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Additive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllergenFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ingredient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Package); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Serving); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nutrient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutritionGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutritionGradeComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DietLabels); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DietLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DietFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackagingPhotos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Photo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chomp_v1beta1_food_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountryDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chomp_v1beta1_food_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_chomp_v1beta1_food_proto_goTypes,
+		DependencyIndexes: file_chomp_v1beta1_food_proto_depIdxs,
+		MessageInfos:      file_chomp_v1beta1_food_proto_msgTypes,
+	}.Build()
+	File_chomp_v1beta1_food_proto = out.File
+	file_chomp_v1beta1_food_proto_rawDesc = nil
+	file_chomp_v1beta1_food_proto_goTypes = nil
+	file_chomp_v1beta1_food_proto_depIdxs = nil
+}
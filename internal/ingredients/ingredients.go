@@ -0,0 +1,190 @@
+// Package ingredients parses the free-form ingredient statements printed on
+// food labels into a tree of ingredients and their sub-ingredients.
+package ingredients
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Ingredient is a single ingredient, possibly made up of sub-ingredients
+// (e.g. "chocolate (sugar, cocoa butter)").
+type Ingredient struct {
+	Name string
+	// Percent is the share of the product the label states this ingredient
+	// makes up, if HasPercent is true.
+	Percent    float64
+	HasPercent bool
+	// MaxPercent is set for ingredients listed after a marker such as
+	// "contains 2% or less of", and is the most each of them can make up.
+	MaxPercent  float64
+	Ingredients []Ingredient
+}
+
+var (
+	// prefix matches a leading "Ingredients:" label.
+	prefix = regexp.MustCompile(`(?i)^\s*ingredients?\s*:\s*`)
+
+	// marker matches phrases like "contains 2% or less of",
+	// "less than 2% of each of the following:" or "2% or less of:".
+	marker = regexp.MustCompile(`(?i)^(?:and\s+)?(?:contains\s+)?(?:less than\s+)?(\d+(?:\.\d+)?)\s*%\s*(?:or less\s+)?of(?:\s+each of the following|\s+the following)?\s*:?\s*`)
+
+	// allergenStatement matches the start of an allergen statement like
+	// "Contains: milk" or "May contain peanuts". A marker like "contains 2%
+	// or less of" is checked first, so it isn't mistaken for one.
+	allergenStatement = regexp.MustCompile(`(?i)^(?:contains|may (?:also )?contain)\b`)
+
+	// leadingPercent and trailingPercent match a stated percentage, optionally
+	// parenthesized, at the start or end of an ingredient.
+	leadingPercent  = regexp.MustCompile(`^\(?\s*(\d+(?:\.\d+)?)\s*%\s*\)?\s*`)
+	trailingPercent = regexp.MustCompile(`\s*\(?\s*(\d+(?:\.\d+)?)\s*%\s*\)?$`)
+)
+
+// Parse parses an ingredient statement, such as
+// "Enriched flour (wheat flour, niacin), sugar 12%, contains 2% or less of:
+// salt, soy lecithin". A trailing allergen statement, such as "Contains:
+// milk" or "May contain peanuts", isn't part of the ingredients and is left
+// out.
+func Parse(text string) []Ingredient {
+	text = prefix.ReplaceAllString(text, "")
+	// Statements often continue in another sentence, as in "...salt.
+	// Contains 2% or less of: ...", so top-level periods separate
+	// ingredients too.
+	segments := split(text, true)
+	for i, segment := range segments {
+		segment = strings.TrimSpace(segment)
+		if allergenStatement.MatchString(segment) && !marker.MatchString(segment) {
+			segments = segments[:i]
+			break
+		}
+	}
+	return parseList(segments)
+}
+
+func parseList(segments []string) []Ingredient {
+	var out []Ingredient
+	maxPercent := 0.0
+	for _, segment := range segments {
+		segment = strings.TrimSpace(segment)
+		if m := marker.FindStringSubmatch(segment); m != nil {
+			if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+				maxPercent = v
+			}
+			segment = segment[len(m[0]):]
+		}
+		ing, ok := parseIngredient(segment)
+		if !ok {
+			continue
+		}
+		ing.MaxPercent = maxPercent
+		out = append(out, ing)
+	}
+	return out
+}
+
+// parseIngredient parses a single ingredient and its sub-ingredients.
+func parseIngredient(s string) (Ingredient, bool) {
+	s = strings.TrimSpace(s)
+	var ing Ingredient
+
+	if m := leadingPercent.FindStringSubmatch(s); m != nil {
+		ing.Percent, _ = strconv.ParseFloat(m[1], 64)
+		ing.HasPercent = true
+		s = s[len(m[0]):]
+	}
+
+	// Sub-ingredients are enclosed in the first top-level parentheses or
+	// brackets. Anything after them is usually a stated percentage.
+	var name, sub, rest string
+	if open := strings.IndexAny(s, "([{"); open >= 0 {
+		if close := matching(s, open); close > open {
+			name, sub, rest = s[:open], s[open+1:close], s[close+1:]
+		} else {
+			// Unbalanced; treat everything after the opening bracket as
+			// sub-ingredients.
+			name, sub = s[:open], s[open+1:]
+		}
+	} else {
+		name = s
+	}
+
+	for _, part := range []*string{&name, &rest} {
+		if m := trailingPercent.FindStringSubmatch(*part); m != nil {
+			ing.Percent, _ = strconv.ParseFloat(m[1], 64)
+			ing.HasPercent = true
+			*part = (*part)[:len(*part)-len(m[0])]
+		}
+	}
+
+	// Parentheses holding only a percentage, like "sugar (12%)", aren't
+	// sub-ingredients.
+	if m := leadingPercent.FindStringSubmatch(strings.TrimSpace(sub)); m != nil && len(m[0]) == len(strings.TrimSpace(sub)) {
+		ing.Percent, _ = strconv.ParseFloat(m[1], 64)
+		ing.HasPercent = true
+		sub = ""
+	}
+
+	ing.Name = cleanName(name)
+	if sub != "" {
+		ing.Ingredients = parseList(split(sub, false))
+	}
+	if ing.Name == "" && len(ing.Ingredients) == 0 {
+		return Ingredient{}, false
+	}
+	return ing, true
+}
+
+// split splits a list on commas and semicolons that aren't nested inside
+// parentheses or brackets. If sentences is true, periods that end a sentence
+// split the list too.
+func split(s string, sentences bool) []string {
+	var out []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+			}
+		case ',', ';':
+			if depth == 0 {
+				out = append(out, s[start:i])
+				start = i + 1
+			}
+		case '.':
+			endsSentence := i == len(s)-1 || s[i+1] == ' '
+			if sentences && depth == 0 && endsSentence {
+				out = append(out, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(out, s[start:])
+}
+
+// matching returns the index of the bracket closing the one at open, or -1.
+func matching(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func cleanName(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.Trim(s, " .:*")
+	s = strings.TrimPrefix(s, "and ")
+	return strings.TrimSpace(s)
+}
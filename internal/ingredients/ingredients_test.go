@@ -0,0 +1,113 @@
+package ingredients
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected []Ingredient
+	}{
+		"flat list": {
+			in: "Water, sugar, salt",
+			expected: []Ingredient{
+				{Name: "Water"},
+				{Name: "sugar"},
+				{Name: "salt"},
+			},
+		},
+		"nested sub-ingredients and percentages": {
+			in: "INGREDIENTS: ENRICHED FLOUR (WHEAT FLOUR, NIACIN, THIAMINE MONONITRATE {VITAMIN B1}), SUGAR, " +
+				"SEMISWEET CHOCOLATE CHIPS (SUGAR, CHOCOLATE, COCOA BUTTER) 12%, SALT. " +
+				"CONTAINS 2% OR LESS OF: BAKING SODA, NATURAL FLAVOR.",
+			expected: []Ingredient{
+				{
+					Name: "ENRICHED FLOUR",
+					Ingredients: []Ingredient{
+						{Name: "WHEAT FLOUR"},
+						{Name: "NIACIN"},
+						{Name: "THIAMINE MONONITRATE", Ingredients: []Ingredient{{Name: "VITAMIN B1"}}},
+					},
+				},
+				{Name: "SUGAR"},
+				{
+					Name:       "SEMISWEET CHOCOLATE CHIPS",
+					Percent:    12,
+					HasPercent: true,
+					Ingredients: []Ingredient{
+						{Name: "SUGAR"},
+						{Name: "CHOCOLATE"},
+						{Name: "COCOA BUTTER"},
+					},
+				},
+				{Name: "SALT"},
+				{Name: "BAKING SODA", MaxPercent: 2},
+				{Name: "NATURAL FLAVOR", MaxPercent: 2},
+			},
+		},
+		"percentage forms": {
+			in: "tomatoes 45.5%, (10%) onions, basil (2%), olive oil",
+			expected: []Ingredient{
+				{Name: "tomatoes", Percent: 45.5, HasPercent: true},
+				{Name: "onions", Percent: 10, HasPercent: true},
+				{Name: "basil", Percent: 2, HasPercent: true},
+				{Name: "olive oil"},
+			},
+		},
+		"less than marker within the same sentence": {
+			in: "Milk, cream, less than 1% of each of the following: salt, and enzymes",
+			expected: []Ingredient{
+				{Name: "Milk"},
+				{Name: "cream"},
+				{Name: "salt", MaxPercent: 1},
+				{Name: "enzymes", MaxPercent: 1},
+			},
+		},
+		"marker with a nested ingredient": {
+			in: "Oats, contains 2% or less of: spices (cinnamon, nutmeg)",
+			expected: []Ingredient{
+				{Name: "Oats"},
+				{Name: "spices", MaxPercent: 2, Ingredients: []Ingredient{{Name: "cinnamon"}, {Name: "nutmeg"}}},
+			},
+		},
+		"allergen statements": {
+			in: "Sugar, peanuts. Contains: milk, peanuts.",
+			expected: []Ingredient{
+				{Name: "Sugar"},
+				{Name: "peanuts"},
+			},
+		},
+		"precautionary allergen statement": {
+			in: "Oats, honey, contains less than 2% of salt. May contain tree nuts",
+			expected: []Ingredient{
+				{Name: "Oats"},
+				{Name: "honey"},
+				{Name: "salt", MaxPercent: 2},
+			},
+		},
+		"decimal points and empty segments": {
+			in: "vitamin B12, , niacin 0.5%. Riboflavin (vitamin B2)",
+			expected: []Ingredient{
+				{Name: "vitamin B12"},
+				{Name: "niacin", Percent: 0.5, HasPercent: true},
+				{Name: "Riboflavin", Ingredients: []Ingredient{{Name: "vitamin B2"}}},
+			},
+		},
+		"unbalanced parentheses": {
+			in: "cocoa (cocoa mass, sugar",
+			expected: []Ingredient{
+				{Name: "cocoa", Ingredients: []Ingredient{{Name: "cocoa mass"}, {Name: "sugar"}}},
+			},
+		},
+		"empty": {
+			in: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Parse(tc.in))
+		})
+	}
+}
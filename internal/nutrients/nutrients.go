@@ -0,0 +1,84 @@
+// Package nutrients normalizes the nutrient names reported by Chomp.
+package nutrients
+
+import "strings"
+
+// Canonical nutrient names.
+const (
+	Energy       = "energy"
+	Fat          = "fat"
+	SaturatedFat = "saturated_fat"
+	Cholesterol  = "cholesterol"
+	Sodium       = "sodium"
+	Salt         = "salt"
+	Carbohydrate = "carbohydrate"
+	Fiber        = "fiber"
+	Sugars       = "sugars"
+	AddedSugars  = "added_sugars"
+	Protein      = "protein"
+	VitaminA     = "vitamin_a"
+	VitaminC     = "vitamin_c"
+	VitaminD     = "vitamin_d"
+	Calcium      = "calcium"
+	Iron         = "iron"
+	Potassium    = "potassium"
+)
+
+// aliases maps the nutrient names Chomp reports (which mostly follow USDA
+// FoodData Central) to canonical names.
+var aliases = map[string]string{
+	"energy":                         Energy,
+	"calories":                       Energy,
+	"total lipid (fat)":              Fat,
+	"total fat":                      Fat,
+	"fat":                            Fat,
+	"fatty acids, total saturated":   SaturatedFat,
+	"saturated fat":                  SaturatedFat,
+	"cholesterol":                    Cholesterol,
+	"sodium, na":                     Sodium,
+	"sodium":                         Sodium,
+	"salt":                           Salt,
+	"carbohydrate, by difference":    Carbohydrate,
+	"total carbohydrate":             Carbohydrate,
+	"carbohydrates":                  Carbohydrate,
+	"fiber, total dietary":           Fiber,
+	"dietary fiber":                  Fiber,
+	"fibre":                          Fiber,
+	"sugars, total":                  Sugars,
+	"sugars, total including nlea":   Sugars,
+	"total sugars":                   Sugars,
+	"sugars":                         Sugars,
+	"sugars, added":                  AddedSugars,
+	"added sugars":                   AddedSugars,
+	"protein":                        Protein,
+	"vitamin a, rae":                 VitaminA,
+	"vitamin a":                      VitaminA,
+	"vitamin c, total ascorbic acid": VitaminC,
+	"vitamin c":                      VitaminC,
+	"vitamin d (d2 + d3)":            VitaminD,
+	"vitamin d":                      VitaminD,
+	"calcium, ca":                    Calcium,
+	"calcium":                        Calcium,
+	"iron, fe":                       Iron,
+	"iron":                           Iron,
+	"potassium, k":                   Potassium,
+	"potassium":                      Potassium,
+}
+
+// Canonical returns the canonical name of a nutrient, e.g. "sodium" for
+// "Sodium, Na".
+func Canonical(name string) (string, bool) {
+	c, ok := aliases[strings.ToLower(strings.TrimSpace(name))]
+	return c, ok
+}
+
+// IsCanonical reports whether name is a canonical nutrient name, e.g. true for
+// "sodium" but false for "Sodium, Na".
+func IsCanonical(name string) bool {
+	for _, c := range aliases {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
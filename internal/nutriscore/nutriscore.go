@@ -0,0 +1,275 @@
+// Package nutriscore computes the Nutri-Score of a food from its nutrients,
+// following the 2017 algorithm used by Santé publique France.
+package nutriscore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Category selects which variant of the algorithm applies to a food.
+type Category int
+
+const (
+	General Category = iota
+	Beverage
+	Cheese
+	// Fat covers added fats such as oils and butter, which are scored on
+	// their ratio of saturated to total fat.
+	Fat
+	// Water is always graded A.
+	Water
+)
+
+func (c Category) String() string {
+	switch c {
+	case Beverage:
+		return "beverage"
+	case Cheese:
+		return "cheese"
+	case Fat:
+		return "fat"
+	case Water:
+		return "water"
+	default:
+		return "general"
+	}
+}
+
+// Input holds a food's nutrients, per 100g (or 100ml for beverages).
+// FruitVegPercent is the share of fruits, vegetables, legumes and nuts. Leave
+// it at 0 if it isn't known, which awards no points for them.
+type Input struct {
+	Category        Category
+	EnergyKJ        float64
+	SugarsG         float64
+	SaturatedFatG   float64
+	FatG            float64
+	SodiumMg        float64
+	FiberG          float64
+	ProteinG        float64
+	FruitVegPercent float64
+}
+
+// Component is one nutrient's contribution to the score.
+type Component struct {
+	Nutrient  string
+	Value     float64
+	Unit      string
+	Points    int
+	MaxPoints int
+	// Positive components count towards a better score.
+	Positive bool
+	// Counted is false if the component was excluded from the score, which
+	// happens to protein in foods with many negative points.
+	Counted bool
+}
+
+// Result is a Nutri-Score.
+type Result struct {
+	Grade          string
+	Score          int
+	NegativePoints int
+	PositivePoints int
+	Components     []Component
+	Category       Category
+}
+
+var (
+	energyThresholds           = []float64{335, 670, 1005, 1340, 1675, 2010, 2345, 2680, 3015, 3350}
+	sugarsThresholds           = []float64{4.5, 9, 13.5, 18, 22.5, 27, 31, 36, 40, 45}
+	saturatedFatThresholds     = []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	saturatedFatRatioThreshold = []float64{10, 16, 22, 28, 34, 40, 46, 52, 58, 64}
+	sodiumThresholds           = []float64{90, 180, 270, 360, 450, 540, 630, 720, 810, 900}
+	fiberThresholds            = []float64{0.9, 1.9, 2.8, 3.7, 4.7}
+	proteinThresholds          = []float64{1.6, 3.2, 4.8, 6.4, 8.0}
+
+	beverageEnergyThresholds = []float64{0, 30, 60, 90, 120, 150, 180, 210, 240, 270}
+	beverageSugarsThresholds = []float64{0, 1.5, 3, 4.5, 6, 7.5, 9, 10.5, 12, 13.5}
+)
+
+// points returns the number of thresholds a value exceeds.
+func points(v float64, thresholds []float64) int {
+	p := 0
+	for _, t := range thresholds {
+		if v > t {
+			p++
+		}
+	}
+	return p
+}
+
+func fruitVegPoints(percent float64, beverage bool) int {
+	switch {
+	case percent > 80 && beverage:
+		return 10
+	case percent > 80:
+		return 5
+	case percent > 60 && beverage:
+		return 4
+	case percent > 60:
+		return 2
+	case percent > 40 && beverage:
+		return 2
+	case percent > 40:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Compute scores a food.
+func Compute(in Input) Result {
+	if in.Category == Water {
+		return Result{Grade: "A", Category: Water}
+	}
+	beverage := in.Category == Beverage
+
+	energy := Component{Nutrient: "energy", Value: in.EnergyKJ, Unit: "kJ", MaxPoints: 10, Counted: true}
+	sugars := Component{Nutrient: "sugars", Value: in.SugarsG, Unit: "g", MaxPoints: 10, Counted: true}
+	saturatedFat := Component{Nutrient: "saturated fat", Value: in.SaturatedFatG, Unit: "g", MaxPoints: 10, Counted: true}
+	sodium := Component{Nutrient: "sodium", Value: in.SodiumMg, Unit: "mg", MaxPoints: 10, Counted: true}
+	fruitVeg := Component{Nutrient: "fruits, vegetables and nuts", Value: in.FruitVegPercent, Unit: "%", MaxPoints: 5, Positive: true, Counted: true}
+	fiber := Component{Nutrient: "fiber", Value: in.FiberG, Unit: "g", MaxPoints: 5, Positive: true, Counted: true}
+	protein := Component{Nutrient: "protein", Value: in.ProteinG, Unit: "g", MaxPoints: 5, Positive: true, Counted: true}
+
+	if beverage {
+		energy.Points = points(in.EnergyKJ, beverageEnergyThresholds)
+		sugars.Points = points(in.SugarsG, beverageSugarsThresholds)
+		fruitVeg.MaxPoints = 10
+	} else {
+		energy.Points = points(in.EnergyKJ, energyThresholds)
+		sugars.Points = points(in.SugarsG, sugarsThresholds)
+	}
+	if in.Category == Fat {
+		ratio := 0.0
+		if in.FatG > 0 {
+			ratio = in.SaturatedFatG / in.FatG * 100
+		}
+		saturatedFat = Component{Nutrient: "saturated fat ratio", Value: ratio, Unit: "%", MaxPoints: 10, Counted: true}
+		saturatedFat.Points = points(ratio, saturatedFatRatioThreshold)
+	} else {
+		saturatedFat.Points = points(in.SaturatedFatG, saturatedFatThresholds)
+	}
+	sodium.Points = points(in.SodiumMg, sodiumThresholds)
+	fruitVeg.Points = fruitVegPoints(in.FruitVegPercent, beverage)
+	fiber.Points = points(in.FiberG, fiberThresholds)
+	protein.Points = points(in.ProteinG, proteinThresholds)
+
+	negative := energy.Points + sugars.Points + saturatedFat.Points + sodium.Points
+
+	// Protein isn't counted for foods with many negative points, unless
+	// they're mostly fruit and vegetables or are cheese.
+	if negative >= 11 && fruitVeg.Points < 5 && in.Category != Cheese && !beverage {
+		protein.Counted = false
+	}
+	positive := fruitVeg.Points + fiber.Points
+	if protein.Counted {
+		positive += protein.Points
+	}
+
+	score := negative - positive
+	return Result{
+		Grade:          grade(score, beverage),
+		Score:          score,
+		NegativePoints: negative,
+		PositivePoints: positive,
+		Components:     []Component{energy, sugars, saturatedFat, sodium, fruitVeg, fiber, protein},
+		Category:       in.Category,
+	}
+}
+
+func grade(score int, beverage bool) string {
+	if beverage {
+		switch {
+		case score <= 1:
+			return "B"
+		case score <= 5:
+			return "C"
+		case score <= 9:
+			return "D"
+		default:
+			return "E"
+		}
+	}
+	switch {
+	case score <= -1:
+		return "A"
+	case score <= 2:
+		return "B"
+	case score <= 10:
+		return "C"
+	case score <= 18:
+		return "D"
+	default:
+		return "E"
+	}
+}
+
+// Explain describes which nutrients drove a score, e.g. "Graded D (score 14):
+// penalized for sugars (7/10) and saturated fat (5/10); credited for fiber
+// (2/5)."
+func Explain(r Result) string {
+	if r.Category == Water {
+		return "Graded A: water is always graded A."
+	}
+
+	var negatives, positives []Component
+	for _, c := range r.Components {
+		if c.Points == 0 || !c.Counted {
+			continue
+		}
+		if c.Positive {
+			positives = append(positives, c)
+		} else {
+			negatives = append(negatives, c)
+		}
+	}
+	byPoints := func(cs []Component) {
+		sort.SliceStable(cs, func(i, j int) bool {
+			return cs[i].Points > cs[j].Points
+		})
+	}
+	byPoints(negatives)
+	byPoints(positives)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Graded %s (score %d)", r.Grade, r.Score)
+	var clauses []string
+	if len(negatives) > 0 {
+		clauses = append(clauses, "penalized for "+list(negatives))
+	}
+	if len(positives) > 0 {
+		clauses = append(clauses, "credited for "+list(positives))
+	}
+	if len(clauses) > 0 {
+		b.WriteString(": ")
+		b.WriteString(strings.Join(clauses, "; "))
+	}
+	b.WriteString(".")
+	for _, c := range r.Components {
+		if !c.Counted {
+			fmt.Fprintf(&b, " %s was not counted because of the high number of negative points.", capitalize(c.Nutrient))
+		}
+	}
+	return b.String()
+}
+
+func list(cs []Component) string {
+	parts := make([]string, len(cs))
+	for i, c := range cs {
+		parts[i] = fmt.Sprintf("%s (%d/%d)", c.Nutrient, c.Points, c.MaxPoints)
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
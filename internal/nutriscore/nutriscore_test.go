@@ -0,0 +1,125 @@
+package nutriscore
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	tests := map[string]struct {
+		in       Input
+		grade    string
+		score    int
+		negative int
+		positive int
+	}{
+		"cola": {
+			in: Input{
+				Category: Beverage,
+				EnergyKJ: 180,
+				SugarsG:  10.6,
+			},
+			grade:    "E",
+			score:    14,
+			negative: 14,
+		},
+		"hazelnut spread": {
+			in: Input{
+				EnergyKJ:        2252,
+				SugarsG:         56.3,
+				SaturatedFatG:   10.6,
+				FatG:            30.9,
+				SodiumMg:        42.8,
+				FiberG:          3.4,
+				ProteinG:        6.3,
+				FruitVegPercent: 13,
+			},
+			grade:    "E",
+			score:    23,
+			negative: 26,
+			positive: 3,
+		},
+		"plain yogurt": {
+			in: Input{
+				EnergyKJ:      250,
+				SugarsG:       4,
+				SaturatedFatG: 2.1,
+				SodiumMg:      50,
+				ProteinG:      3.5,
+			},
+			grade:    "B",
+			score:    0,
+			negative: 2,
+			positive: 2,
+		},
+		"oats": {
+			in: Input{
+				EnergyKJ:      1550,
+				SugarsG:       1,
+				SaturatedFatG: 1.3,
+				SodiumMg:      2,
+				FiberG:        10,
+				ProteinG:      13,
+			},
+			grade:    "A",
+			score:    -5,
+			negative: 5,
+			positive: 10,
+		},
+		"cheddar counts protein": {
+			in: Input{
+				Category:      Cheese,
+				EnergyKJ:      1710,
+				SugarsG:       0.1,
+				SaturatedFatG: 21,
+				SodiumMg:      640,
+				ProteinG:      25,
+			},
+			grade:    "D",
+			score:    17,
+			negative: 22,
+			positive: 5,
+		},
+		"olive oil uses saturated fat ratio": {
+			in: Input{
+				Category:      Fat,
+				EnergyKJ:      3700,
+				SaturatedFatG: 14,
+				FatG:          100,
+			},
+			grade:    "D",
+			score:    11,
+			negative: 11,
+		},
+		"water": {
+			in:    Input{Category: Water},
+			grade: "A",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := Compute(tc.in)
+			require.Equal(t, tc.grade, r.Grade)
+			require.Equal(t, tc.score, r.Score)
+			require.Equal(t, tc.negative, r.NegativePoints)
+			require.Equal(t, tc.positive, r.PositivePoints)
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	r := Compute(Input{
+		EnergyKJ:      2252,
+		SugarsG:       56.3,
+		SaturatedFatG: 10.6,
+		SodiumMg:      42.8,
+		FiberG:        3.4,
+		ProteinG:      6.3,
+	})
+	require.Equal(t,
+		"Graded E (score 23): penalized for sugars (10/10), saturated fat (10/10) and energy (6/10); "+
+			"credited for fiber (3/5). Protein was not counted because of the high number of negative points.",
+		Explain(r))
+
+	require.Equal(t, "Graded A: water is always graded A.", Explain(Compute(Input{Category: Water})))
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"github.com/kevinmichaelchen/chomp-proxy/internal/additives"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+)
+
+// detectAdditives returns the additives recognized in an item's
+// ingredients.
+func detectAdditives(in ChompFoodItem) []*chompv1beta1.Additive {
+	var out []*chompv1beta1.Additive
+	for _, m := range additives.Scan(in.Ingredients) {
+		out = append(out, &chompv1beta1.Additive{
+			Code:          m.Code,
+			Name:          m.Name,
+			FunctionClass: m.Class,
+			RiskNotes:     m.RiskNotes,
+			MatchedTerms:  m.Terms,
+		})
+	}
+	return out
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"github.com/kevinmichaelchen/chomp-proxy/internal/allergens"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"strings"
+)
+
+// allergenFinding accumulates what's known about one allergen in an item.
+type allergenFinding struct {
+	*chompv1beta1.AllergenFinding
+	// contained is true if the item contains the allergen, rather than only
+	// possibly containing traces of it.
+	contained bool
+	// reportedAsAllergen and reportedAsTrace are true if Chomp's allergens
+	// and traces already cover the allergen.
+	reportedAsAllergen bool
+	reportedAsTrace    bool
+}
+
+// detectAllergens scans an item's ingredients for allergens using the
+// dictionary, records every allergen found in the food's allergen findings,
+// and merges detected allergens into its allergens and traces.
+func detectAllergens(out *chompv1beta1.Food, in ChompFoodItem, dict *allergens.Dictionary) {
+	if dict == nil {
+		return
+	}
+
+	var findings []*allergenFinding
+	byName := make(map[string]*allergenFinding)
+	get := func(name string) *allergenFinding {
+		key := strings.ToLower(name)
+		f, ok := byName[key]
+		if !ok {
+			f = &allergenFinding{AllergenFinding: &chompv1beta1.AllergenFinding{Name: name}}
+			byName[key] = f
+			findings = append(findings, f)
+		}
+		return f
+	}
+
+	// provided records allergens reported by Chomp. Unrecognized names are
+	// kept as they are. A name resolves to the allergen named like it, or
+	// else to its first match. Other matches are only implied by it, e.g.
+	// gluten by "Wheat", so they're inferred rather than provided.
+	provided := func(values []string, trace bool) {
+		for _, v := range values {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				continue
+			}
+			matches := dict.Scan(v)
+			if len(matches) == 0 {
+				matches = []allergens.Match{{Allergen: v}}
+			}
+			resolved := 0
+			for i, m := range matches {
+				if strings.EqualFold(m.Allergen, v) {
+					resolved = i
+					break
+				}
+			}
+			for i, m := range matches {
+				f := get(m.Allergen)
+				f.Regulations = m.Regulations
+				f.contained = f.contained || !trace
+				if i != resolved {
+					f.Inferred = true
+					f.MatchedTerms = appendUnique(f.MatchedTerms, m.Terms...)
+					continue
+				}
+				f.Provided = true
+				f.reportedAsAllergen = f.reportedAsAllergen || !trace
+				f.reportedAsTrace = f.reportedAsTrace || trace
+			}
+		}
+	}
+
+	// inferred records allergens detected in ingredient text.
+	inferred := func(text string, trace bool) {
+		for _, m := range dict.Scan(text) {
+			f := get(m.Allergen)
+			f.Inferred = true
+			f.Regulations = m.Regulations
+			f.MatchedTerms = appendUnique(f.MatchedTerms, m.Terms...)
+			f.contained = f.contained || !trace
+		}
+	}
+
+	provided(in.Allergens, false)
+	provided(in.Traces, true)
+
+	ingredientText, traceText := allergens.SplitTraces(in.Ingredients)
+	inferred(ingredientText, false)
+	for _, ing := range in.IngredientList {
+		inferred(ing, false)
+	}
+	inferred(traceText, true)
+
+	// Don't append to the slices shared with the Chomp item.
+	out.Allergens = append([]string(nil), out.GetAllergens()...)
+	out.Traces = append([]string(nil), out.GetTraces()...)
+	for _, f := range findings {
+		f.Trace = !f.contained
+		out.AllergenFindings = append(out.AllergenFindings, f.AllergenFinding)
+
+		switch {
+		case f.contained && !f.reportedAsAllergen:
+			out.Allergens = append(out.Allergens, f.GetName())
+		case !f.contained && !f.reportedAsTrace:
+			out.Traces = append(out.Traces, f.GetName())
+		}
+	}
+}
+
+func appendUnique(to []string, values ...string) []string {
+	for _, v := range values {
+		exists := false
+		for _, w := range to {
+			if w == v {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			to = append(to, v)
+		}
+	}
+	return to
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/allergens"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/logging"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultAlternativesLimit = 5
+	maxAlternativesLimit     = 20
+
+	// The number of the original item's categories and keywords used to search
+	// for alternatives. Each costs an upstream call.
+	alternativeSearchCategories = 2
+	alternativeSearchKeywords   = 1
+
+	// How much similarity and nutrition grade contribute to an alternative's
+	// rank score.
+	similarityWeight = 0.6
+	gradeWeight      = 0.4
+
+	// Candidates less similar than this aren't suggested, no matter how
+	// healthy they are.
+	minAlternativeSimilarity = 0.1
+)
+
+func (s *Service) SuggestAlternatives(
+	ctx context.Context,
+	req *connect.Request[chompv1beta1.SuggestAlternativesRequest],
+) (*connect.Response[chompv1beta1.SuggestAlternativesResponse], error) {
+	log := logging.FromContext(ctx)
+
+	// Get API key
+	log.Info("Retrieving API key...")
+	apiKey, err := getAPIKey(req.Header())
+	if err != nil {
+		log.WithError(err).Error("missing API key")
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	limit := int(req.Msg.GetLimit())
+	if limit < 0 || limit > maxAlternativesLimit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be between 0 and %d", maxAlternativesLimit))
+	}
+	if limit == 0 {
+		limit = defaultAlternativesLimit
+	}
+
+	opts, err := s.newConvertOptions(req.Msg.GetUnitSystem(), req.Msg.GetDailyValueProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.getFoodByBarcode(ctx, apiKey, req.Msg.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	original := convert(item, opts)
+
+	candidates, err := s.searchAlternatives(ctx, apiKey, item)
+	if err != nil {
+		return nil, err
+	}
+
+	c := constraints{
+		Diets:            req.Msg.GetDiets(),
+		ExcludeAllergens: req.Msg.GetExcludeAllergens(),
+		ExcludeTraces:    req.Msg.GetExcludeTraces(),
+		Allergens:        s.allergens,
+	}
+	var foods []*chompv1beta1.Food
+	for _, candidate := range candidates {
+		food := convert(candidate, opts)
+		if c.allows(food) {
+			foods = append(foods, food)
+		}
+	}
+
+	res := &chompv1beta1.SuggestAlternativesResponse{
+		Food:         original,
+		Alternatives: rankAlternatives(original, foods, limit),
+	}
+
+	out := connect.NewResponse(res)
+	out.Header().Set("API-Version", "v1beta1")
+	return out, nil
+}
+
+// searchAlternatives searches Chomp for items sharing the original item's
+// leading categories and keywords. Results are de-duplicated and exclude the
+// original item. A failed search is skipped, so the other searches' results
+// are still suggested, unless every search fails.
+func (s *Service) searchAlternatives(ctx context.Context, apiKey string, in ChompFoodItem) ([]ChompFoodItem, error) {
+	log := logging.FromContext(ctx)
+
+	var queries []url.Values
+	for i, c := range in.Categories {
+		if i == alternativeSearchCategories {
+			break
+		}
+		queries = append(queries, url.Values{"category": {c}})
+	}
+	for i, k := range in.Keywords {
+		if i == alternativeSearchKeywords {
+			break
+		}
+		queries = append(queries, url.Values{"keyword": {k}})
+	}
+
+	var out []ChompFoodItem
+	var lastErr error
+	failed := 0
+	seen := map[string]bool{in.Barcode: true}
+	for _, q := range queries {
+		log := log.WithField("query", q.Encode())
+		log.Info("Searching for alternatives...")
+
+		q.Set("api_key", apiKey)
+		apiRes, err := s.hitAPI(ctx, "https://chompthis.com/api/v2/food/branded/search.php?"+q.Encode())
+		if err != nil {
+			log.WithError(err).Warn("search failed, skipping it")
+			lastErr = err
+			failed++
+			continue
+		}
+		for _, item := range apiRes.Items {
+			if seen[item.Barcode] {
+				continue
+			}
+			seen[item.Barcode] = true
+			out = append(out, item)
+		}
+	}
+	if failed > 0 && failed == len(queries) {
+		log.WithError(lastErr).Error("every search failed")
+		return nil, connect.NewError(connect.CodeInternal, lastErr)
+	}
+	return out, nil
+}
+
+// constraints are the dietary requirements alternatives must satisfy.
+type constraints struct {
+	Diets            []chompv1beta1.Diet
+	ExcludeAllergens []string
+	ExcludeTraces    bool
+	// Allergens is the dictionary used to compare allergens by canonical
+	// name, so "nuts" matches "Cashews" but not "Coconut". If it's nil,
+	// names must be equal, ignoring case.
+	Allergens *allergens.Dictionary
+}
+
+func (c constraints) allows(f *chompv1beta1.Food) bool {
+	for _, d := range c.Diets {
+		var label *chompv1beta1.DietLabel
+		switch d {
+		case chompv1beta1.Diet_DIET_VEGAN:
+			label = f.GetDietLabels().GetVegan()
+		case chompv1beta1.Diet_DIET_VEGETARIAN:
+			label = f.GetDietLabels().GetVegetarian()
+		case chompv1beta1.Diet_DIET_GLUTEN_FREE:
+			label = f.GetDietLabels().GetGlutenFree()
+		default:
+			continue
+		}
+		if !label.GetIsCompatible() {
+			return false
+		}
+	}
+
+	excluded := make(map[string]bool)
+	for _, a := range c.ExcludeAllergens {
+		for _, name := range c.Allergens.Canonical(a) {
+			excluded[name] = true
+		}
+	}
+	contains := func(values []string) bool {
+		for _, v := range values {
+			for _, name := range c.Allergens.Canonical(v) {
+				if excluded[name] {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return !contains(f.GetAllergens()) && !(c.ExcludeTraces && contains(f.GetTraces()))
+}
+
+// rankAlternatives orders candidates by a blend of their similarity to the
+// original and their nutrition grade, returning at most limit of them.
+func rankAlternatives(original *chompv1beta1.Food, candidates []*chompv1beta1.Food, limit int) []*chompv1beta1.Alternative {
+	var out []*chompv1beta1.Alternative
+	for _, c := range candidates {
+		similarity := foodSimilarity(original, c)
+		if similarity < minAlternativeSimilarity {
+			continue
+		}
+		out = append(out, &chompv1beta1.Alternative{
+			Food:             c,
+			Similarity:       similarity,
+			RankScore:        similarityWeight*similarity + gradeWeight*gradeScore(c.GetNutritionGrade()),
+			SharedCategories: intersect(original.GetCategories(), c.GetCategories()),
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].GetRankScore() > out[j].GetRankScore()
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// foodSimilarity scores how alike two foods are from 0 to 1, weighing shared
+// categories most, then keywords, then words in their names.
+func foodSimilarity(a, b *chompv1beta1.Food) float64 {
+	return 0.5*jaccard(a.GetCategories(), b.GetCategories()) +
+		0.3*jaccard(a.GetKeywords(), b.GetKeywords()) +
+		0.2*jaccard(strings.Fields(a.GetName()), strings.Fields(b.GetName()))
+}
+
+// gradeScore maps a nutrition grade onto 0 (E, or ungraded) to 1 (A).
+func gradeScore(g *chompv1beta1.NutritionGrade) float64 {
+	switch g.GetGrade() {
+	case "A":
+		return 1
+	case "B":
+		return 0.75
+	case "C":
+		return 0.5
+	case "D":
+		return 0.25
+	default:
+		return 0
+	}
+}
+
+func normalizedSet(values []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, v := range values {
+		if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+			set[v] = true
+		}
+	}
+	return set
+}
+
+// jaccard returns the Jaccard index of two sets of strings, ignoring case.
+func jaccard(a, b []string) float64 {
+	setA, setB := normalizedSet(a), normalizedSet(b)
+	if len(setA) == 0 && len(setB) == 0 {
+		return 0
+	}
+	shared := 0
+	for v := range setA {
+		if setB[v] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(setA)+len(setB)-shared)
+}
+
+// intersect returns the values of b that are also in a, ignoring case.
+func intersect(a, b []string) []string {
+	setA := normalizedSet(a)
+	var out []string
+	for _, v := range b {
+		if setA[strings.ToLower(strings.TrimSpace(v))] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
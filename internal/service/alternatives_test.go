@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/allergens"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestConstraintsAllows(t *testing.T) {
+	oatMilk := &chompv1beta1.Food{
+		Allergens: []string{"Oats"},
+		Traces:    []string{"Tree nuts"},
+		DietLabels: &chompv1beta1.DietLabels{
+			Vegan:      &chompv1beta1.DietLabel{IsCompatible: true},
+			Vegetarian: &chompv1beta1.DietLabel{IsCompatible: true},
+			GlutenFree: &chompv1beta1.DietLabel{IsCompatible: false},
+		},
+	}
+
+	tests := map[string]struct {
+		c        constraints
+		expected bool
+	}{
+		"no constraints": {
+			expected: true,
+		},
+		"compatible diets": {
+			c:        constraints{Diets: []chompv1beta1.Diet{chompv1beta1.Diet_DIET_VEGAN, chompv1beta1.Diet_DIET_VEGETARIAN}},
+			expected: true,
+		},
+		"incompatible diet": {
+			c: constraints{Diets: []chompv1beta1.Diet{chompv1beta1.Diet_DIET_VEGAN, chompv1beta1.Diet_DIET_GLUTEN_FREE}},
+		},
+		"excluded allergen": {
+			c: constraints{ExcludeAllergens: []string{"oats"}},
+		},
+		"trace ignored by default": {
+			c:        constraints{ExcludeAllergens: []string{"nuts"}},
+			expected: true,
+		},
+		"excluded trace": {
+			c: constraints{ExcludeAllergens: []string{"nuts"}, ExcludeTraces: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.c.Allergens = allergens.Builtin()
+			require.Equal(t, tc.expected, tc.c.allows(oatMilk))
+		})
+	}
+
+	// Allergens are compared by canonical name, not by substring.
+	coconutBar := &chompv1beta1.Food{
+		Allergens: []string{"Coconut"},
+		Traces:    []string{"Nutmeg"},
+	}
+	c := constraints{ExcludeAllergens: []string{"nut"}, ExcludeTraces: true, Allergens: allergens.Builtin()}
+	require.True(t, c.allows(coconutBar))
+	require.False(t, c.allows(&chompv1beta1.Food{Allergens: []string{"Cashews"}}))
+	require.True(t, constraints{ExcludeAllergens: []string{"nut"}}.allows(coconutBar))
+	require.False(t, constraints{ExcludeAllergens: []string{"COCONUT"}}.allows(coconutBar))
+
+	// Items without diet labels aren't known to be compatible.
+	require.False(t, constraints{Diets: []chompv1beta1.Diet{chompv1beta1.Diet_DIET_VEGAN}}.allows(&chompv1beta1.Food{}))
+}
+
+func TestRankAlternatives(t *testing.T) {
+	original := &chompv1beta1.Food{
+		Name:       "Whole Milk",
+		Categories: []string{"Milk", "Dairy"},
+		Keywords:   []string{"milk", "whole"},
+	}
+	soy := &chompv1beta1.Food{
+		Name:           "Soy Milk",
+		Categories:     []string{"Milk", "Plant-Based Milk"},
+		Keywords:       []string{"milk", "soy"},
+		NutritionGrade: &chompv1beta1.NutritionGrade{Grade: "B"},
+	}
+	oat := &chompv1beta1.Food{
+		Name:           "Oat Milk",
+		Categories:     []string{"Milk", "Plant-Based Milk"},
+		Keywords:       []string{"milk", "oat"},
+		NutritionGrade: &chompv1beta1.NutritionGrade{Grade: "D"},
+	}
+	juice := &chompv1beta1.Food{
+		Name:           "Orange Juice",
+		Categories:     []string{"Juice"},
+		NutritionGrade: &chompv1beta1.NutritionGrade{Grade: "A"},
+	}
+
+	alternatives := rankAlternatives(original, []*chompv1beta1.Food{oat, juice, soy}, 2)
+	require.Len(t, alternatives, 2)
+	require.Equal(t, "Soy Milk", alternatives[0].GetFood().GetName())
+	require.Equal(t, "Oat Milk", alternatives[1].GetFood().GetName())
+	require.Equal(t, []string{"Milk"}, alternatives[0].GetSharedCategories())
+	// Categories: 1/3 shared, keywords: 1/3 shared, name: 1/3 shared.
+	require.InDelta(t, 1.0/3, alternatives[0].GetSimilarity(), 1e-9)
+	require.InDelta(t, 0.6/3+0.4*0.75, alternatives[0].GetRankScore(), 1e-9)
+}
+
+func TestSuggestAlternatives(t *testing.T) {
+	// Each search returns some of the same items, and the category search
+	// returns the original item too.
+	searches := map[string][]string{
+		"category=Cereal":    {"0001", "0002", "0003"},
+		"category=Breakfast": {"0002", "0004"},
+		"keyword=oat":        {"0003", "0005"},
+	}
+	item := func(barcode string) string {
+		return fmt.Sprintf(`{"barcode": %q, "name": "Oat Cereal %s", "categories": ["Cereal", "Breakfast"], "keywords": ["oat"]}`, barcode, barcode)
+	}
+
+	tests := map[string]struct {
+		code  string
+		limit int32
+		// failing are the searches that fail.
+		failing      []string
+		wantCode     connect.Code
+		wantBarcodes []string
+		wantLookups  int
+	}{
+		"de-duplicated without the original": {
+			code:         "0001",
+			wantBarcodes: []string{"0002", "0003", "0004", "0005"},
+			wantLookups:  4,
+		},
+		"limit": {
+			code:         "0001",
+			limit:        2,
+			wantBarcodes: []string{"0002", "0003"},
+			wantLookups:  4,
+		},
+		"failed search skipped": {
+			code:         "0001",
+			failing:      []string{"category=Breakfast"},
+			wantBarcodes: []string{"0002", "0003", "0005"},
+			wantLookups:  4,
+		},
+		"every search failed": {
+			code:        "0001",
+			failing:     []string{"category=Cereal", "category=Breakfast", "keyword=oat"},
+			wantCode:    connect.CodeInternal,
+			wantLookups: 4,
+		},
+		"original not found": {
+			code:        "missing",
+			wantCode:    connect.CodeNotFound,
+			wantLookups: 1,
+		},
+		"negative limit": {
+			code:     "0001",
+			limit:    -1,
+			wantCode: connect.CodeInvalidArgument,
+		},
+		"limit too large": {
+			code:     "0001",
+			limit:    maxAlternativesLimit + 1,
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var lookups int
+			client := newUpstream(t, func(w http.ResponseWriter, r *http.Request) {
+				lookups++
+				q := r.URL.Query()
+				if strings.HasSuffix(r.URL.Path, "/barcode.php") {
+					if q.Get("code") == "missing" {
+						fmt.Fprint(w, `{"items": []}`)
+						return
+					}
+					fmt.Fprintf(w, `{"items": [%s]}`, item(q.Get("code")))
+					return
+				}
+				q.Del("api_key")
+				for _, f := range tc.failing {
+					if q.Encode() == f {
+						fmt.Fprint(w, "Service Unavailable")
+						return
+					}
+				}
+				var items []string
+				for _, barcode := range searches[q.Encode()] {
+					items = append(items, item(barcode))
+				}
+				fmt.Fprintf(w, `{"items": [%s]}`, strings.Join(items, ","))
+			})
+			svc := NewService(dailyvalue.Builtin(), nil, client)
+
+			req := connect.NewRequest(&chompv1beta1.SuggestAlternativesRequest{Code: tc.code, Limit: tc.limit})
+			req.Header().Set("api_key", "secret")
+			res, err := svc.SuggestAlternatives(context.Background(), req)
+			require.Equal(t, tc.wantLookups, lookups)
+			if tc.wantCode != 0 {
+				require.Equal(t, tc.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.code, res.Msg.GetFood().GetBarcode())
+
+			var barcodes []string
+			for _, a := range res.Msg.GetAlternatives() {
+				barcodes = append(barcodes, a.GetFood().GetBarcode())
+			}
+			require.Equal(t, tc.wantBarcodes, barcodes)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/nutrients"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/logging"
+	"strings"
+)
+
+const (
+	minCompareFoods = 2
+	maxCompareFoods = 5
+)
+
+func (s *Service) CompareFoods(
+	ctx context.Context,
+	req *connect.Request[chompv1beta1.CompareFoodsRequest],
+) (*connect.Response[chompv1beta1.CompareFoodsResponse], error) {
+	log := logging.FromContext(ctx)
+
+	// Get API key
+	log.Info("Retrieving API key...")
+	apiKey, err := getAPIKey(req.Header())
+	if err != nil {
+		log.WithError(err).Error("missing API key")
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	codes := req.Msg.GetCodes()
+	if len(codes) < minCompareFoods || len(codes) > maxCompareFoods {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("between %d and %d barcodes must be compared, got %d", minCompareFoods, maxCompareFoods, len(codes)))
+	}
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		if seen[code] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("barcode %s is repeated", code))
+		}
+		seen[code] = true
+	}
+
+	opts, err := s.newConvertOptions(req.Msg.GetUnitSystem(), req.Msg.GetDailyValueProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	var foods []*chompv1beta1.Food
+	for _, code := range codes {
+		item, err := s.getFoodByBarcode(ctx, apiKey, code)
+		if err != nil {
+			return nil, err
+		}
+		foods = append(foods, convert(item, opts))
+	}
+
+	res := compareFoods(foods)
+
+	out := connect.NewResponse(res)
+	out.Header().Set("API-Version", "v1beta1")
+	return out, nil
+}
+
+// compareFoods builds a side-by-side comparison of foods.
+func compareFoods(foods []*chompv1beta1.Food) *chompv1beta1.CompareFoodsResponse {
+	return &chompv1beta1.CompareFoodsResponse{
+		Foods:       foods,
+		Nutrients:   compareNutrients(foods),
+		Allergens:   comparePresence(foods, (*chompv1beta1.Food).GetAllergens),
+		Traces:      comparePresence(foods, (*chompv1beta1.Food).GetTraces),
+		Ingredients: comparePresence(foods, (*chompv1beta1.Food).GetIngredientList),
+		Diets:       compareDiets(foods),
+	}
+}
+
+// compareNutrients aligns the foods' nutrients by name, in order of first
+// appearance. Amounts are converted to the unit the nutrient was first seen
+// in when possible; otherwise the nutrient is listed once per unit.
+func compareNutrients(foods []*chompv1beta1.Food) []*chompv1beta1.NutrientComparison {
+	var out []*chompv1beta1.NutrientComparison
+	byName := make(map[string][]*chompv1beta1.NutrientComparison)
+	for i, f := range foods {
+		for _, n := range f.GetNutrients() {
+			key := strings.ToLower(strings.TrimSpace(n.GetName()))
+			if c, ok := nutrients.Canonical(n.GetName()); ok {
+				key = c
+			}
+
+			var comparison *chompv1beta1.NutrientComparison
+			value := n.GetPer_100GValue()
+			for _, c := range byName[key] {
+				if converted, ok := convertAmount(value, n.GetMeasurementUnit(), c.GetMeasurementUnit()); ok {
+					comparison = c
+					value = converted
+					break
+				}
+			}
+			if comparison == nil {
+				comparison = &chompv1beta1.NutrientComparison{
+					Name:            n.GetName(),
+					MeasurementUnit: n.GetMeasurementUnit(),
+					Values:          make([]*chompv1beta1.ComparedValue, len(foods)),
+				}
+				for j := range comparison.Values {
+					comparison.Values[j] = &chompv1beta1.ComparedValue{}
+				}
+				byName[key] = append(byName[key], comparison)
+				out = append(out, comparison)
+			}
+			comparison.Values[i].Available = true
+			comparison.Values[i].Value = value
+		}
+	}
+	return out
+}
+
+// comparePresence reports which foods have each value of a list field,
+// ignoring case and keeping the first spelling seen.
+func comparePresence(foods []*chompv1beta1.Food, field func(*chompv1beta1.Food) []string) []*chompv1beta1.PresenceComparison {
+	var out []*chompv1beta1.PresenceComparison
+	byKey := make(map[string]*chompv1beta1.PresenceComparison)
+	for i, f := range foods {
+		for _, v := range field(f) {
+			key := strings.ToLower(strings.TrimSpace(v))
+			if key == "" {
+				continue
+			}
+			c, ok := byKey[key]
+			if !ok {
+				c = &chompv1beta1.PresenceComparison{
+					Name:    strings.TrimSpace(v),
+					Present: make([]bool, len(foods)),
+				}
+				byKey[key] = c
+				out = append(out, c)
+			}
+			c.Present[i] = true
+		}
+	}
+	for _, c := range out {
+		c.Shared = true
+		for _, p := range c.Present {
+			c.Shared = c.Shared && p
+		}
+	}
+	return out
+}
+
+// compareDiets lines up each food's label for every supported diet.
+func compareDiets(foods []*chompv1beta1.Food) []*chompv1beta1.DietComparison {
+	diets := []struct {
+		name  string
+		label func(*chompv1beta1.DietLabels) *chompv1beta1.DietLabel
+	}{
+		{name: "vegan", label: (*chompv1beta1.DietLabels).GetVegan},
+		{name: "vegetarian", label: (*chompv1beta1.DietLabels).GetVegetarian},
+		{name: "gluten_free", label: (*chompv1beta1.DietLabels).GetGlutenFree},
+	}
+
+	var out []*chompv1beta1.DietComparison
+	for _, d := range diets {
+		c := &chompv1beta1.DietComparison{Diet: d.name}
+		for _, f := range foods {
+			label := d.label(f.GetDietLabels())
+			if label == nil {
+				label = &chompv1beta1.DietLabel{}
+			}
+			c.Labels = append(c.Labels, label)
+			if label.GetIsCompatible() != c.Labels[0].GetIsCompatible() {
+				c.Differs = true
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestCompareFoods(t *testing.T) {
+	vegan := &chompv1beta1.DietLabel{Name: "Vegan", IsCompatible: true, CompatibilityLevel: 3}
+	notVegan := &chompv1beta1.DietLabel{Name: "Vegan", IsCompatible: false}
+	vegetarian := &chompv1beta1.DietLabel{Name: "Vegetarian", IsCompatible: true, CompatibilityLevel: 3}
+
+	flakes := &chompv1beta1.Food{
+		Name: "Corn Flakes",
+		Nutrients: []*chompv1beta1.Nutrient{
+			{Name: "Energy", Per_100GValue: 357, MeasurementUnit: "kcal"},
+			{Name: "Sugars, total", Per_100GValue: 8, MeasurementUnit: "g"},
+			{Name: "Sodium, Na", Per_100GValue: 0.7, MeasurementUnit: "g"},
+		},
+		Allergens:      []string{"Barley"},
+		IngredientList: []string{"Milled corn", "Sugar", "Malt flavor", "Salt"},
+		DietLabels:     &chompv1beta1.DietLabels{Vegan: vegan, Vegetarian: vegetarian},
+	}
+	granola := &chompv1beta1.Food{
+		Name: "Honey Granola",
+		Nutrients: []*chompv1beta1.Nutrient{
+			{Name: "Energy", Per_100GValue: 1900, MeasurementUnit: "kJ"},
+			{Name: "Sodium, Na", Per_100GValue: 120, MeasurementUnit: "mg"},
+			{Name: "Fiber, total dietary", Per_100GValue: 6, MeasurementUnit: "g"},
+		},
+		Allergens:      []string{"Oats", "barley"},
+		Traces:         []string{"Peanuts"},
+		IngredientList: []string{"Oats", "Honey", "sugar"},
+		DietLabels:     &chompv1beta1.DietLabels{Vegan: notVegan, Vegetarian: vegetarian},
+	}
+
+	res := compareFoods([]*chompv1beta1.Food{flakes, granola})
+
+	expected := &chompv1beta1.CompareFoodsResponse{
+		Foods: []*chompv1beta1.Food{flakes, granola},
+		Nutrients: []*chompv1beta1.NutrientComparison{
+			{
+				Name:            "Energy",
+				MeasurementUnit: "kcal",
+				Values: []*chompv1beta1.ComparedValue{
+					{Available: true, Value: 357},
+					{Available: true, Value: 1900 / 4.184},
+				},
+			},
+			{
+				Name:            "Sugars, total",
+				MeasurementUnit: "g",
+				Values: []*chompv1beta1.ComparedValue{
+					{Available: true, Value: 8},
+					{},
+				},
+			},
+			{
+				Name:            "Sodium, Na",
+				MeasurementUnit: "g",
+				Values: []*chompv1beta1.ComparedValue{
+					{Available: true, Value: 0.7},
+					{Available: true, Value: 0.12},
+				},
+			},
+			{
+				Name:            "Fiber, total dietary",
+				MeasurementUnit: "g",
+				Values: []*chompv1beta1.ComparedValue{
+					{},
+					{Available: true, Value: 6},
+				},
+			},
+		},
+		Allergens: []*chompv1beta1.PresenceComparison{
+			{Name: "Barley", Present: []bool{true, true}, Shared: true},
+			{Name: "Oats", Present: []bool{false, true}},
+		},
+		Traces: []*chompv1beta1.PresenceComparison{
+			{Name: "Peanuts", Present: []bool{false, true}},
+		},
+		Ingredients: []*chompv1beta1.PresenceComparison{
+			{Name: "Milled corn", Present: []bool{true, false}},
+			{Name: "Sugar", Present: []bool{true, true}, Shared: true},
+			{Name: "Malt flavor", Present: []bool{true, false}},
+			{Name: "Salt", Present: []bool{true, false}},
+			{Name: "Oats", Present: []bool{false, true}},
+			{Name: "Honey", Present: []bool{false, true}},
+		},
+		Diets: []*chompv1beta1.DietComparison{
+			{Diet: "vegan", Labels: []*chompv1beta1.DietLabel{vegan, notVegan}, Differs: true},
+			{Diet: "vegetarian", Labels: []*chompv1beta1.DietLabel{vegetarian, vegetarian}},
+			{Diet: "gluten_free", Labels: []*chompv1beta1.DietLabel{{}, {}}},
+		},
+	}
+	diff := cmp.Diff(expected, res, protocmp.Transform(), cmpopts.EquateApprox(0, 1e-9))
+	require.Empty(t, diff)
+}
+
+func TestCompareFoodsHandler(t *testing.T) {
+	tests := map[string]struct {
+		codes    []string
+		apiKey   string
+		wantCode connect.Code
+		// wantLookups is the number of barcodes looked up in Chomp.
+		wantLookups int
+	}{
+		"two foods": {
+			codes:       []string{"0001", "0002"},
+			apiKey:      "secret",
+			wantLookups: 2,
+		},
+		"too few": {
+			codes:    []string{"0001"},
+			apiKey:   "secret",
+			wantCode: connect.CodeInvalidArgument,
+		},
+		"too many": {
+			codes:    []string{"0001", "0002", "0003", "0004", "0005", "0006"},
+			apiKey:   "secret",
+			wantCode: connect.CodeInvalidArgument,
+		},
+		"repeated barcode": {
+			codes:    []string{"0001", "0002", "0001"},
+			apiKey:   "secret",
+			wantCode: connect.CodeInvalidArgument,
+		},
+		"one barcode not found": {
+			codes:       []string{"0001", "missing"},
+			apiKey:      "secret",
+			wantCode:    connect.CodeNotFound,
+			wantLookups: 2,
+		},
+		"missing API key": {
+			codes:    []string{"0001", "0002"},
+			wantCode: connect.CodePermissionDenied,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var lookups []string
+			client := newUpstream(t, func(w http.ResponseWriter, r *http.Request) {
+				code := r.URL.Query().Get("code")
+				mu.Lock()
+				lookups = append(lookups, code)
+				mu.Unlock()
+				if code == "missing" {
+					fmt.Fprint(w, `{"items": []}`)
+					return
+				}
+				fmt.Fprintf(w, `{"items": [{"barcode": %q, "name": "Food %s", "nutrients": [{"name": "Energy", "per_100g": 100, "measurement_unit": "kcal"}]}]}`, code, code)
+			})
+			svc := NewService(dailyvalue.Builtin(), nil, client)
+
+			req := connect.NewRequest(&chompv1beta1.CompareFoodsRequest{Codes: tc.codes})
+			if tc.apiKey != "" {
+				req.Header().Set("api_key", tc.apiKey)
+			}
+			res, err := svc.CompareFoods(context.Background(), req)
+			require.Len(t, lookups, tc.wantLookups)
+			if tc.wantCode != 0 {
+				require.Equal(t, tc.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "v1beta1", res.Header().Get("API-Version"))
+
+			foods := res.Msg.GetFoods()
+			require.Len(t, foods, len(tc.codes))
+			for i, code := range tc.codes {
+				require.Equal(t, "Food "+code, foods[i].GetName())
+			}
+			require.Len(t, res.Msg.GetNutrients(), 1)
+			require.Len(t, res.Msg.GetNutrients()[0].GetValues(), len(tc.codes))
+		})
+	}
+}
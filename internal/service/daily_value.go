@@ -0,0 +1,45 @@
+package service
+
+import (
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+)
+
+// dailyValueProfile returns the reference profile with the given name, or the
+// default profile if the name is empty.
+func (s *Service) dailyValueProfile(name string) (dailyvalue.Profile, error) {
+	if name == "" {
+		name = dailyvalue.DefaultProfile
+	}
+	p, ok := s.profiles[name]
+	if !ok {
+		return dailyvalue.Profile{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown daily value profile %q", name))
+	}
+	return p, nil
+}
+
+// addDailyValues sets the daily value of each of the food's nutrients that
+// the profile has a reference intake for.
+func addDailyValues(out *chompv1beta1.Food, profile dailyvalue.Profile) {
+	if profile.Name == "" {
+		return
+	}
+	for _, n := range out.GetNutrients() {
+		ref, pct, ok := profile.Percent(n.GetName(), n.GetPer_100GValue(), n.GetMeasurementUnit())
+		if !ok {
+			continue
+		}
+		dv := &chompv1beta1.DailyValue{
+			Profile:         profile.Name,
+			ReferenceAmount: ref.Amount,
+			ReferenceUnit:   ref.Unit,
+			Per_100GPercent: pct,
+		}
+		if n.GetPerServingAvailable() {
+			_, dv.PerServingPercent, _ = profile.Percent(n.GetName(), n.GetPerServing(), n.GetMeasurementUnit())
+		}
+		n.DailyValue = dv
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"time"
+)
+
+// pingBarcode is looked up to check that Chomp is reachable. Any barcode
+// works; Chomp responds even if it doesn't know it.
+const pingBarcode = "0000000000000"
+
+// pingTTL is how long a Ping result is reused, so however often Chomp's
+// health is probed, it's looked up at most once per pingTTL.
+const pingTTL = 10 * time.Second
+
+// pingResult is the outcome of the last Ping.
+type pingResult struct {
+	apiKey    string
+	err       error
+	checkedAt time.Time
+}
+
+// Ping checks that Chomp is reachable and accepts apiKey. Chomp has no
+// cheaper endpoint, so each lookup is billed to apiKey; the result is reused
+// for pingTTL.
+func (s *Service) Ping(ctx context.Context, apiKey string) error {
+	s.pingMu.Lock()
+	defer s.pingMu.Unlock()
+	if s.lastPing.apiKey == apiKey && time.Since(s.lastPing.checkedAt) < pingTTL {
+		return s.lastPing.err
+	}
+	err := s.ping(ctx, apiKey)
+	// Don't reuse a result that's down to the caller giving up.
+	if ctx.Err() == nil {
+		s.lastPing = pingResult{apiKey: apiKey, err: err, checkedAt: time.Now()}
+	}
+	return err
+}
+
+func (s *Service) ping(ctx context.Context, apiKey string) error {
+	url := fmt.Sprintf("https://chompthis.com/api/v2/food/branded/barcode.php?api_key=%s&code=%s", apiKey, pingBarcode)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request for Chomp API: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// The URL contains the API key, so leave it out of the error.
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("chomp API is unreachable: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("chomp API rejected the API key: %s", resp.Status)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("chomp API is unavailable: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/ingredients"
+)
+
+// ingredientTree converts parsed ingredients into their protobuf form.
+func ingredientTree(in []ingredients.Ingredient) []*chompv1beta1.Ingredient {
+	var out []*chompv1beta1.Ingredient
+	for _, ing := range in {
+		out = append(out, &chompv1beta1.Ingredient{
+			Name:             ing.Name,
+			Percent:          ing.Percent,
+			PercentAvailable: ing.HasPercent,
+			MaxPercent:       ing.MaxPercent,
+			Ingredients:      ingredientTree(ing.Ingredients),
+		})
+	}
+	return out
+}
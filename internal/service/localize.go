@@ -0,0 +1,83 @@
+package service
+
+import (
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/units"
+	"strings"
+)
+
+// unitSystem maps the requested unit system onto the units package.
+func unitSystem(in chompv1beta1.UnitSystem) units.System {
+	switch in {
+	case chompv1beta1.UnitSystem_UNIT_SYSTEM_METRIC:
+		return units.Metric
+	case chompv1beta1.UnitSystem_UNIT_SYSTEM_IMPERIAL:
+		return units.Imperial
+	default:
+		return units.AsIs
+	}
+}
+
+// localize expresses the serving size, package size and energy amounts of a
+// food in the given unit system. Nutrient masses (g, mg, mcg) are left alone,
+// since both metric and imperial labels report them in metric units.
+func localize(out *chompv1beta1.Food, in ChompFoodItem, sys units.System) {
+	if sys == units.AsIs {
+		return
+	}
+
+	if q, ok := servingQuantity(in); ok {
+		q = units.In(q, sys)
+		out.Serving.Size = units.FormatValue(q.Value)
+		out.Serving.MeasurementUnit = q.Unit.Symbol
+	}
+
+	if q, ok := packageQuantity(in); ok {
+		out.Package.Size = units.In(q, sys).String()
+	}
+
+	for _, n := range out.GetNutrients() {
+		u, ok := units.LookupUnit(n.GetMeasurementUnit())
+		if !ok || u.Dimension != units.Energy {
+			continue
+		}
+		to := units.Preferred(u, sys)
+		if to == u {
+			continue
+		}
+		// The deprecated per_100g is left in Chomp's unit, so clients that
+		// predate unit systems aren't affected.
+		n.Per_100GValue = convertValue(n.GetPer_100GValue(), u, to)
+		n.PerServing = convertValue(n.GetPerServing(), u, to)
+		n.PerPackage = convertValue(n.GetPerPackage(), u, to)
+		n.MeasurementUnit = to.Symbol
+	}
+}
+
+func convertValue(v float64, from, to units.Unit) float64 {
+	q, err := units.Convert(units.Quantity{Value: v, Unit: from}, to)
+	if err != nil {
+		return v
+	}
+	return q.Value
+}
+
+// convertAmount converts an amount between two nutrient measurement units.
+func convertAmount(amount float64, from, to string) (float64, bool) {
+	if strings.EqualFold(from, to) {
+		return amount, true
+	}
+	fromUnit, ok := units.LookupUnit(from)
+	if !ok {
+		return 0, false
+	}
+	toUnit, ok := units.LookupUnit(to)
+	if !ok {
+		return 0, false
+	}
+	q, err := units.Convert(units.Quantity{Value: amount, Unit: fromUnit}, toUnit)
+	if err != nil {
+		return 0, false
+	}
+	return q.Value, true
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/units"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/logging"
+	"golang.org/x/sync/errgroup"
+	"math"
+	"strings"
+)
+
+// maxMealItems is the most items a meal can have, since each one is looked up
+// in Chomp.
+const maxMealItems = 20
+
+// maxConcurrentLookups bounds how many of a meal's items are looked up in
+// Chomp at once.
+const maxConcurrentLookups = 5
+
+func (s *Service) CalculateMeal(
+	ctx context.Context,
+	req *connect.Request[chompv1beta1.CalculateMealRequest],
+) (*connect.Response[chompv1beta1.CalculateMealResponse], error) {
+	log := logging.FromContext(ctx)
+
+	// Get API key
+	log.Info("Retrieving API key...")
+	apiKey, err := getAPIKey(req.Header())
+	if err != nil {
+		log.WithError(err).Error("missing API key")
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if len(req.Msg.GetItems()) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("meal has no items"))
+	}
+	if len(req.Msg.GetItems()) > maxMealItems {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("a meal can have at most %d items, got %d", maxMealItems, len(req.Msg.GetItems())))
+	}
+
+	opts, err := s.newConvertOptions(req.Msg.GetUnitSystem(), req.Msg.GetDailyValueProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	foods, err := s.getMealFoods(ctx, apiKey, req.Msg.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	var portions []portion
+	var items []*chompv1beta1.MealItemResult
+	for i, mi := range req.Msg.GetItems() {
+		item := foods[i]
+		grams, err := mealItemGrams(item, mi.GetQuantity(), mi.GetUnit())
+		if err != nil {
+			log.WithError(err).WithField("barcode", mi.GetCode()).Error("invalid meal item")
+			return nil, err
+		}
+		food := convert(item, opts)
+		portions = append(portions, portion{Food: food, Grams: grams})
+		items = append(items, &chompv1beta1.MealItemResult{
+			Item:  mi,
+			Food:  food,
+			Grams: grams,
+		})
+	}
+
+	res := &chompv1beta1.CalculateMealResponse{
+		Nutrients:  sumNutrients(portions),
+		Allergens:  union(portions, (*chompv1beta1.Food).GetAllergens),
+		Traces:     union(portions, (*chompv1beta1.Food).GetTraces),
+		DietLabels: strictestDietLabels(portions),
+		Items:      items,
+	}
+
+	out := connect.NewResponse(res)
+	out.Header().Set("API-Version", "v1beta1")
+	return out, nil
+}
+
+// getMealFoods looks up the meal's items concurrently, returning them in the
+// same order. The first failed lookup cancels the rest.
+func (s *Service) getMealFoods(ctx context.Context, apiKey string, items []*chompv1beta1.MealItem) ([]ChompFoodItem, error) {
+	foods := make([]ChompFoodItem, len(items))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLookups)
+	for i, mi := range items {
+		i, code := i, mi.GetCode()
+		g.Go(func() error {
+			item, err := s.getFoodByBarcode(ctx, apiKey, code)
+			if err != nil {
+				return err
+			}
+			foods[i] = item
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return foods, nil
+}
+
+// portion is an amount of a food, in grams.
+type portion struct {
+	Food  *chompv1beta1.Food
+	Grams float64
+}
+
+// mealItemGrams returns the weight in grams of a quantity of an item. The unit
+// can be "serving", "package", any unit of mass, or a unit of volume if the
+// item is a beverage.
+func mealItemGrams(item ChompFoodItem, quantity float64, unit string) (float64, error) {
+	// NaN and infinities are valid doubles, so they must be rejected here.
+	if !(quantity > 0) || math.IsInf(quantity, 0) {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quantity must be positive, got %v", quantity))
+	}
+	switch strings.ToLower(strings.TrimSpace(unit)) {
+	case "", "serving", "servings":
+		g, ok := servingGrams(item)
+		if !ok {
+			return 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("serving size of %s is unknown", item.Barcode))
+		}
+		return quantity * g, nil
+	case "package", "packages":
+		g, ok := packageGrams(item)
+		if !ok {
+			return 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("package size of %s is unknown", item.Barcode))
+		}
+		return quantity * g, nil
+	}
+	u, ok := units.LookupUnit(unit)
+	if !ok {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported unit %q", unit))
+	}
+	if u.Dimension != units.Mass && u.Dimension != units.Volume {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unit %q is not a unit of mass or volume", unit))
+	}
+	g, ok := quantityGrams(item, units.Quantity{Value: quantity, Unit: u})
+	if !ok {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s isn't a beverage, so it can't be measured in %q", item.Barcode, unit))
+	}
+	return g, nil
+}
+
+// sumNutrients totals each nutrient across the portions, in order of first
+// appearance. Amounts of the same nutrient reported in different but
+// compatible units (e.g. kcal and kJ) are converted into the unit seen first.
+func sumNutrients(portions []portion) []*chompv1beta1.NutrientTotal {
+	var totals []*chompv1beta1.NutrientTotal
+	byName := make(map[string][]*chompv1beta1.NutrientTotal)
+	for _, p := range portions {
+		for _, n := range p.Food.GetNutrients() {
+			amount := scalePer100G(n.GetPer_100GValue(), p.Grams)
+			key := strings.ToLower(n.GetName())
+
+			var total *chompv1beta1.NutrientTotal
+			for _, t := range byName[key] {
+				if converted, ok := convertAmount(amount, n.GetMeasurementUnit(), t.GetMeasurementUnit()); ok {
+					total = t
+					amount = converted
+					break
+				}
+			}
+			if total == nil {
+				total = &chompv1beta1.NutrientTotal{
+					Name:            n.GetName(),
+					MeasurementUnit: n.GetMeasurementUnit(),
+				}
+				byName[key] = append(byName[key], total)
+				totals = append(totals, total)
+			}
+			total.Amount += amount
+			total.ItemCount++
+		}
+	}
+	return totals
+}
+
+// union returns the distinct values of a list field across the portions,
+// ignoring case and keeping the first spelling seen.
+func union(portions []portion, field func(*chompv1beta1.Food) []string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, p := range portions {
+		for _, v := range field(p.Food) {
+			key := strings.ToLower(strings.TrimSpace(v))
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// strictestDietLabels combines the diet labels of the portions, keeping the
+// least compatible item's label for each diet.
+func strictestDietLabels(portions []portion) *chompv1beta1.DietLabels {
+	var vegan, vegetarian, glutenFree []*chompv1beta1.DietLabel
+	for _, p := range portions {
+		vegan = append(vegan, p.Food.GetDietLabels().GetVegan())
+		vegetarian = append(vegetarian, p.Food.GetDietLabels().GetVegetarian())
+		glutenFree = append(glutenFree, p.Food.GetDietLabels().GetGlutenFree())
+	}
+	return &chompv1beta1.DietLabels{
+		Vegan:      strictestDietLabel(vegan),
+		Vegetarian: strictestDietLabel(vegetarian),
+		GlutenFree: strictestDietLabel(glutenFree),
+	}
+}
+
+// strictestDietLabel returns the label with the lowest compatibility level.
+// The combined label is only compatible if every label is, and its confidence
+// is the lowest confidence among the labels, along with that label's
+// description of it.
+func strictestDietLabel(labels []*chompv1beta1.DietLabel) *chompv1beta1.DietLabel {
+	var out *chompv1beta1.DietLabel
+	compatible := true
+	for _, l := range labels {
+		if l == nil {
+			continue
+		}
+		compatible = compatible && l.GetIsCompatible()
+		if out == nil || l.GetCompatibilityLevel() < out.GetCompatibilityLevel() ||
+			(l.GetCompatibilityLevel() == out.GetCompatibilityLevel() && l.GetConfidence() < out.GetConfidence()) {
+			out = l
+		}
+	}
+	if out == nil {
+		return nil
+	}
+	leastConfident := out
+	for _, l := range labels {
+		if l != nil && l.GetConfidence() < leastConfident.GetConfidence() {
+			leastConfident = l
+		}
+	}
+	return &chompv1beta1.DietLabel{
+		Name:                  out.GetName(),
+		IsCompatible:          compatible,
+		CompatibilityLevel:    out.GetCompatibilityLevel(),
+		Confidence:            leastConfident.GetConfidence(),
+		ConfidenceDescription: leastConfident.GetConfidenceDescription(),
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMealItemGrams(t *testing.T) {
+	var item ChompFoodItem
+	item.Serving.Size = "30"
+	item.Serving.MeasurementUnit = "g"
+	item.Package.Size = "12 oz"
+
+	tests := map[string]struct {
+		quantity float64
+		unit     string
+		expected float64
+		code     connect.Code
+	}{
+		"default unit is servings": {quantity: 2, expected: 60},
+		"servings":                 {quantity: 1.5, unit: "servings", expected: 45},
+		"package":                  {quantity: 1, unit: "Package", expected: 340.19427750000004},
+		"mass":                     {quantity: 100, unit: "g", expected: 100},
+		"volume of a solid":        {quantity: 1, unit: "cup", code: connect.CodeInvalidArgument},
+		"unknown unit":             {quantity: 1, unit: "handful", code: connect.CodeInvalidArgument},
+		"energy unit":              {quantity: 1, unit: "kcal", code: connect.CodeInvalidArgument},
+		"non-positive quantity":    {quantity: 0, code: connect.CodeInvalidArgument},
+		"NaN quantity":             {quantity: math.NaN(), code: connect.CodeInvalidArgument},
+		"infinite quantity":        {quantity: math.Inf(1), code: connect.CodeInvalidArgument},
+		"negative infinity":        {quantity: math.Inf(-1), code: connect.CodeInvalidArgument},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, err := mealItemGrams(item, tc.quantity, tc.unit)
+			if tc.code != 0 {
+				require.Equal(t, tc.code, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.InDelta(t, tc.expected, g, 1e-9)
+		})
+	}
+
+	var juice ChompFoodItem
+	juice.Package.Size = "64 fl oz"
+	g, err := mealItemGrams(juice, 1, "cup")
+	require.NoError(t, err)
+	require.InDelta(t, 236.5882365, g, 1e-9)
+
+	_, err = mealItemGrams(ChompFoodItem{}, 1, "serving")
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestSumMeal(t *testing.T) {
+	cereal := &chompv1beta1.Food{
+		Nutrients: []*chompv1beta1.Nutrient{
+			{Name: "Energy", Per_100GValue: 380, MeasurementUnit: "kcal"},
+			{Name: "Sugars, total", Per_100GValue: 12.5, MeasurementUnit: "g"},
+		},
+		Allergens: []string{"Wheat"},
+		Traces:    []string{"Nuts"},
+		DietLabels: &chompv1beta1.DietLabels{
+			Vegan:      &chompv1beta1.DietLabel{Name: "Vegan", IsCompatible: true, CompatibilityLevel: 3, Confidence: 2, ConfidenceDescription: "Medium"},
+			Vegetarian: &chompv1beta1.DietLabel{Name: "Vegetarian", IsCompatible: true, CompatibilityLevel: 3, Confidence: 3},
+			GlutenFree: &chompv1beta1.DietLabel{Name: "Gluten Free", IsCompatible: false, CompatibilityLevel: 0, Confidence: 3},
+		},
+	}
+	milk := &chompv1beta1.Food{
+		Nutrients: []*chompv1beta1.Nutrient{
+			{Name: "Energy", Per_100GValue: 200, MeasurementUnit: "kJ"},
+			{Name: "sugars, total", Per_100GValue: 5, MeasurementUnit: "g"},
+			{Name: "Calcium, Ca", Per_100GValue: 120, MeasurementUnit: "mg"},
+		},
+		Allergens: []string{"milk", "wheat"},
+		DietLabels: &chompv1beta1.DietLabels{
+			Vegan:      &chompv1beta1.DietLabel{Name: "Vegan", IsCompatible: false, CompatibilityLevel: 0, Confidence: 3, ConfidenceDescription: "High"},
+			Vegetarian: &chompv1beta1.DietLabel{Name: "Vegetarian", IsCompatible: true, CompatibilityLevel: 3, Confidence: 1},
+			GlutenFree: &chompv1beta1.DietLabel{Name: "Gluten Free", IsCompatible: true, CompatibilityLevel: 3, Confidence: 3},
+		},
+	}
+	portions := []portion{
+		{Food: cereal, Grams: 40},
+		{Food: milk, Grams: 250},
+	}
+
+	expectedNutrients := []*chompv1beta1.NutrientTotal{
+		{Name: "Energy", Amount: 152 + 500/4.184, MeasurementUnit: "kcal", ItemCount: 2},
+		{Name: "Sugars, total", Amount: 17.5, MeasurementUnit: "g", ItemCount: 2},
+		{Name: "Calcium, Ca", Amount: 300, MeasurementUnit: "mg", ItemCount: 1},
+	}
+	diff := cmp.Diff(expectedNutrients, sumNutrients(portions), protocmp.Transform())
+	require.Empty(t, diff)
+
+	require.Equal(t, []string{"Wheat", "milk"}, union(portions, (*chompv1beta1.Food).GetAllergens))
+	require.Equal(t, []string{"Nuts"}, union(portions, (*chompv1beta1.Food).GetTraces))
+
+	expectedLabels := &chompv1beta1.DietLabels{
+		Vegan:      &chompv1beta1.DietLabel{Name: "Vegan", IsCompatible: false, CompatibilityLevel: 0, Confidence: 2, ConfidenceDescription: "Medium"},
+		Vegetarian: &chompv1beta1.DietLabel{Name: "Vegetarian", IsCompatible: true, CompatibilityLevel: 3, Confidence: 1},
+		GlutenFree: &chompv1beta1.DietLabel{Name: "Gluten Free", IsCompatible: false, CompatibilityLevel: 0, Confidence: 3},
+	}
+	diff = cmp.Diff(expectedLabels, strictestDietLabels(portions), protocmp.Transform())
+	require.Empty(t, diff)
+}
+
+func TestCalculateMealItemCount(t *testing.T) {
+	tests := map[string]struct {
+		items    int
+		wantCode connect.Code
+	}{
+		"no items": {items: 0, wantCode: connect.CodeInvalidArgument},
+		"too many": {items: maxMealItems + 1, wantCode: connect.CodeInvalidArgument},
+		"at limit": {items: maxMealItems, wantCode: connect.CodeNotFound},
+		"one item": {items: 1, wantCode: connect.CodeNotFound},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var lookups int32
+			client := newUpstream(t, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&lookups, 1)
+				fmt.Fprint(w, `{"items": []}`)
+			})
+			svc := NewService(dailyvalue.Builtin(), nil, client)
+
+			req := connect.NewRequest(&chompv1beta1.CalculateMealRequest{})
+			req.Header().Set("api_key", "secret")
+			for i := 0; i < tc.items; i++ {
+				req.Msg.Items = append(req.Msg.Items, &chompv1beta1.MealItem{Code: "0123", Quantity: 1, Unit: "serving"})
+			}
+			_, err := svc.CalculateMeal(context.Background(), req)
+			require.Equal(t, tc.wantCode, connect.CodeOf(err))
+			if tc.wantCode == connect.CodeInvalidArgument {
+				require.Zero(t, atomic.LoadInt32(&lookups))
+			}
+		})
+	}
+}
+
+func TestCalculateMealConcurrentLookups(t *testing.T) {
+	var inFlight, maxInFlight int32
+	client := newUpstream(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		code := r.URL.Query().Get("code")
+		fmt.Fprintf(w, `{"items": [{"barcode": %q, "name": "Food %s", "serving": {"size": "10", "measurement_unit": "g"}}]}`, code, code)
+	})
+	svc := NewService(dailyvalue.Builtin(), nil, client)
+
+	req := connect.NewRequest(&chompv1beta1.CalculateMealRequest{})
+	req.Header().Set("api_key", "secret")
+	for i := 0; i < maxMealItems; i++ {
+		req.Msg.Items = append(req.Msg.Items, &chompv1beta1.MealItem{Code: fmt.Sprint(i), Quantity: 1, Unit: "serving"})
+	}
+	res, err := svc.CalculateMeal(context.Background(), req)
+	require.NoError(t, err)
+
+	// The items are looked up concurrently, but no more than the limit at
+	// once, and come back in the order they were requested.
+	require.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
+	require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(maxConcurrentLookups))
+	require.Len(t, res.Msg.GetItems(), maxMealItems)
+	for i, item := range res.Msg.GetItems() {
+		require.Equal(t, fmt.Sprint(i), item.GetItem().GetCode())
+		require.Equal(t, fmt.Sprintf("Food %d", i), item.GetFood().GetName())
+	}
+}
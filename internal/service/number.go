@@ -0,0 +1,48 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Number is a numeric JSON value that Chomp may encode either as a JSON number
+// (e.g. 0.4) or as a string (e.g. "0.4"). Empty strings and null decode to 0.
+type Number float64
+
+func (n *Number) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		*n = 0
+		return nil
+	}
+
+	// Quoted values are unwrapped and parsed, since Chomp is not consistent
+	// about how it encodes numbers.
+	if b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			*n = 0
+			return nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid numeric string %q: %w", s, err)
+		}
+		*n = Number(f)
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+	*n = Number(f)
+	return nil
+}
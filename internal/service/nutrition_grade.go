@@ -0,0 +1,109 @@
+package service
+
+import (
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/nutrients"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/nutriscore"
+	"regexp"
+)
+
+var (
+	waterCategory    = regexp.MustCompile(`(?i)\bwaters?\b`)
+	beverageCategory = regexp.MustCompile(`(?i)\b(beverages?|drinks?|sodas?|juices?|teas?|waters?)\b`)
+	cheeseCategory   = regexp.MustCompile(`(?i)\bcheeses?\b`)
+	fatCategory      = regexp.MustCompile(`(?i)\b(oils?|margarines?|^butter$)\b`)
+)
+
+// nutritionCategory chooses the Nutri-Score variant for a food from its
+// categories.
+func nutritionCategory(categories []string, in nutriscore.Input) nutriscore.Category {
+	match := func(re *regexp.Regexp) bool {
+		for _, c := range categories {
+			if re.MatchString(c) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case match(waterCategory) && in.EnergyKJ == 0 && in.SugarsG == 0:
+		return nutriscore.Water
+	case match(beverageCategory):
+		return nutriscore.Beverage
+	case match(cheeseCategory):
+		return nutriscore.Cheese
+	case match(fatCategory):
+		return nutriscore.Fat
+	default:
+		return nutriscore.General
+	}
+}
+
+// nutritionGrade computes the Nutri-Score of a converted food. It returns nil
+// if any of the nutrients the score requires are missing.
+func nutritionGrade(out *chompv1beta1.Food) *chompv1beta1.NutritionGrade {
+	amounts := make(map[string]float64)
+	for _, n := range out.GetNutrients() {
+		key, ok := nutrients.Canonical(n.GetName())
+		if !ok {
+			continue
+		}
+		unit := "g"
+		switch key {
+		case nutrients.Energy:
+			unit = "kJ"
+		case nutrients.Sodium:
+			unit = "mg"
+		}
+		if v, ok := convertAmount(n.GetPer_100GValue(), n.GetMeasurementUnit(), unit); ok {
+			amounts[key] = v
+		}
+	}
+	// Labels in some countries only report salt, which is 40% sodium.
+	if _, ok := amounts[nutrients.Sodium]; !ok {
+		if salt, ok := amounts[nutrients.Salt]; ok {
+			amounts[nutrients.Sodium] = salt * 400
+		}
+	}
+	for _, required := range []string{nutrients.Energy, nutrients.Sugars, nutrients.SaturatedFat, nutrients.Sodium} {
+		if _, ok := amounts[required]; !ok {
+			return nil
+		}
+	}
+
+	// Chomp doesn't report the share of fruits, vegetables and nuts, and
+	// ingredient statements rarely state it, so FruitVegPercent is left at 0.
+	in := nutriscore.Input{
+		EnergyKJ:      amounts[nutrients.Energy],
+		SugarsG:       amounts[nutrients.Sugars],
+		SaturatedFatG: amounts[nutrients.SaturatedFat],
+		FatG:          amounts[nutrients.Fat],
+		SodiumMg:      amounts[nutrients.Sodium],
+		FiberG:        amounts[nutrients.Fiber],
+		ProteinG:      amounts[nutrients.Protein],
+	}
+	in.Category = nutritionCategory(out.GetCategories(), in)
+	r := nutriscore.Compute(in)
+
+	var components []*chompv1beta1.NutritionGradeComponent
+	for _, c := range r.Components {
+		components = append(components, &chompv1beta1.NutritionGradeComponent{
+			Nutrient:        c.Nutrient,
+			Value:           c.Value,
+			MeasurementUnit: c.Unit,
+			Points:          int32(c.Points),
+			MaxPoints:       int32(c.MaxPoints),
+			Positive:        c.Positive,
+			Counted:         c.Counted,
+		})
+	}
+	return &chompv1beta1.NutritionGrade{
+		Grade:          r.Grade,
+		Score:          int32(r.Score),
+		NegativePoints: int32(r.NegativePoints),
+		PositivePoints: int32(r.PositivePoints),
+		Category:       r.Category.String(),
+		Components:     components,
+		Explanation:    nutriscore.Explain(r),
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"fmt"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/units"
+	"strings"
+)
+
+// servingQuantity returns the size of one serving of the item. The structured
+// size and unit and the full-text description, which often carries a metric
+// equivalent (e.g. "2 crackers (30 g)"), are both parsed. A measure of mass
+// is preferred over a volume, e.g. "3/4 cup (28 g)" is 28 g.
+func servingQuantity(in ChompFoodItem) (units.Quantity, bool) {
+	candidates := []string{
+		strings.TrimSpace(fmt.Sprintf("%s %s", in.Serving.Size, in.Serving.MeasurementUnit)),
+		in.Serving.SizeFulltext,
+	}
+	var measures []units.Quantity
+	for _, c := range candidates {
+		if q, ok := labelQuantity(c); ok {
+			measures = append(measures, q)
+		}
+	}
+	if len(measures) == 0 {
+		return units.Quantity{}, false
+	}
+	return units.PreferMass(measures), true
+}
+
+// packageQuantity returns the size of the item's package.
+func packageQuantity(in ChompFoodItem) (units.Quantity, bool) {
+	return labelQuantity(in.Package.Size)
+}
+
+// labelQuantity parses a label quantity, only accepting positive amounts of
+// mass or volume.
+func labelQuantity(s string) (units.Quantity, bool) {
+	if s == "" {
+		return units.Quantity{}, false
+	}
+	q, err := units.ParseLabel(s)
+	if err != nil {
+		return units.Quantity{}, false
+	}
+	if g, ok := units.Grams(q); !ok || g <= 0 {
+		return units.Quantity{}, false
+	}
+	return q, true
+}
+
+// isBeverage reports whether the item is a beverage: it's sold by volume or
+// categorized as a drink. Nutrients of beverages are reported per 100 ml.
+func isBeverage(in ChompFoodItem) bool {
+	if q, ok := packageQuantity(in); ok && q.Unit.Dimension == units.Volume {
+		return true
+	}
+	for _, c := range in.Categories {
+		c = strings.ToLower(c)
+		if strings.Contains(c, "beverage") || strings.Contains(c, "drink") {
+			return true
+		}
+	}
+	return false
+}
+
+// quantityGrams returns the weight of a quantity of the item in grams. Volumes
+// of beverages are weighed like water, but volumes of solids can't be weighed
+// without their density, which labels don't give.
+func quantityGrams(in ChompFoodItem, q units.Quantity) (float64, bool) {
+	if q.Unit.Dimension == units.Volume && !isBeverage(in) {
+		return 0, false
+	}
+	return units.Grams(q)
+}
+
+// servingGrams returns the weight of one serving of the item in grams.
+func servingGrams(in ChompFoodItem) (float64, bool) {
+	q, ok := servingQuantity(in)
+	if !ok {
+		return 0, false
+	}
+	return quantityGrams(in, q)
+}
+
+// packageGrams returns the weight of the item's package in grams.
+func packageGrams(in ChompFoodItem) (float64, bool) {
+	q, ok := packageQuantity(in)
+	if !ok {
+		return 0, false
+	}
+	return quantityGrams(in, q)
+}
+
+// scalePer100G scales a per-100g amount to the given weight in grams.
+func scalePer100G(per100G, grams float64) float64 {
+	return per100G * grams / 100
+}
@@ -6,58 +6,67 @@ import (
 	"errors"
 	"fmt"
 	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/allergens"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/ingredients"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/units"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/logging"
 	"github.com/sirupsen/logrus"
-	chompv1beta1 "go.buf.build/bufbuild/connect-go/kevinmichaelchen/chompapis/chomp/v1beta1"
 	"io"
 	"net/http"
+	neturl "net/url"
+	"sync"
 )
 
-type Service struct{}
+type Service struct {
+	profiles  dailyvalue.Profiles
+	allergens *allergens.Dictionary
+	client    *http.Client
 
-func NewService() *Service {
-	return &Service{}
+	pingMu   sync.Mutex
+	lastPing pingResult
+}
+
+// NewService returns a Service that calls Chomp using client, or
+// http.DefaultClient if client is nil.
+func NewService(profiles dailyvalue.Profiles, dictionary *allergens.Dictionary, client *http.Client) *Service {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Service{
+		profiles:  profiles,
+		allergens: dictionary,
+		client:    client,
+	}
 }
 
 func (s *Service) GetFood(
 	ctx context.Context,
 	req *connect.Request[chompv1beta1.GetFoodRequest],
 ) (*connect.Response[chompv1beta1.GetFoodResponse], error) {
+	log := logging.FromContext(ctx)
+
 	// Get API key
-	logrus.Info("Retrieving API key...")
+	log.Info("Retrieving API key...")
 	apiKey, err := getAPIKey(req.Header())
 	if err != nil {
-		logrus.WithError(err).Error("missing API key")
+		log.WithError(err).Error("missing API key")
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	if true {
-		return connect.NewResponse(&chompv1beta1.GetFoodResponse{
-			Food: &chompv1beta1.Food{Name: "Fake data"},
-		}), nil
-	}
-
-	logrus.WithField("barcode", req.Msg.GetCode()).Info("Retrieving food...")
-
-	barcode := req.Msg.GetCode()
-	url := fmt.Sprintf("https://chompthis.com/api/v2/food/branded/barcode.php?api_key=%s&code=%s", apiKey, barcode)
-
-	// Hit Chomp API
-	apiRes, err := hitAPI(url)
+	opts, err := s.newConvertOptions(req.Msg.GetUnitSystem(), req.Msg.GetDailyValueProfile())
 	if err != nil {
-		logrus.WithError(err).Error("call failed")
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
-	// Check for Not Found
-	if len(apiRes.Items) == 0 {
-		logrus.Error("no food items found")
-		return nil, connect.NewError(connect.CodeNotFound, errors.New("no foods found"))
+	item, err := s.getFoodByBarcode(ctx, apiKey, req.Msg.GetCode())
+	if err != nil {
+		return nil, err
 	}
 
-	logrus.Info("Success")
-
 	res := &chompv1beta1.GetFoodResponse{
-		Food: convert(apiRes.Items[0]),
+		Food: convert(item, opts),
 	}
 
 	out := connect.NewResponse(res)
@@ -69,37 +78,44 @@ func (s *Service) ListFoods(
 	ctx context.Context,
 	req *connect.Request[chompv1beta1.ListFoodsRequest],
 ) (*connect.Response[chompv1beta1.ListFoodsResponse], error) {
+	log := logging.FromContext(ctx)
+
 	// Get API key
-	logrus.Info("Retrieving API key...")
+	log.Info("Retrieving API key...")
 	apiKey, err := getAPIKey(req.Header())
 	if err != nil {
-		logrus.WithError(err).Error("missing API key")
+		log.WithError(err).Error("missing API key")
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	logrus.WithField("query", req.Msg.GetName()).Info("Retrieving foods...")
+	opts, err := s.newConvertOptions(req.Msg.GetUnitSystem(), req.Msg.GetDailyValueProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithField("query", req.Msg.GetName()).Info("Retrieving foods...")
 
 	name := req.Msg.GetName()
 	url := fmt.Sprintf("https://chompthis.com/api/v2/food/branded/name.php?api_key=%s&name=%s", apiKey, name)
 
 	// Hit Chomp API
-	apiRes, err := hitAPI(url)
+	apiRes, err := s.hitAPI(ctx, url)
 	if err != nil {
-		logrus.WithError(err).Error("call failed")
+		log.WithError(err).Error("call failed")
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	// Check for Not Found
 	if len(apiRes.Items) == 0 {
-		logrus.Error("no food items found")
+		log.Error("no food items found")
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("no foods found"))
 	}
 
-	logrus.Info("Success")
+	log.Info("Success")
 
 	var items []*chompv1beta1.Food
 	for _, item := range apiRes.Items {
-		items = append(items, convert(item))
+		items = append(items, convert(item, opts))
 	}
 	res := &chompv1beta1.ListFoodsResponse{
 		Items: items,
@@ -110,6 +126,33 @@ func (s *Service) ListFoods(
 	return out, nil
 }
 
+// getFoodByBarcode looks up a single branded food item by its barcode. Errors
+// are returned as Connect errors.
+func (s *Service) getFoodByBarcode(ctx context.Context, apiKey, barcode string) (ChompFoodItem, error) {
+	log := logging.FromContext(ctx)
+
+	log.WithField("barcode", barcode).Info("Retrieving food...")
+
+	url := fmt.Sprintf("https://chompthis.com/api/v2/food/branded/barcode.php?api_key=%s&code=%s", apiKey, barcode)
+
+	// Hit Chomp API
+	apiRes, err := s.hitAPI(ctx, url)
+	if err != nil {
+		log.WithError(err).Error("call failed")
+		return ChompFoodItem{}, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Check for Not Found
+	if len(apiRes.Items) == 0 {
+		log.Error("no food items found")
+		return ChompFoodItem{}, connect.NewError(connect.CodeNotFound, errors.New("no foods found"))
+	}
+
+	log.Info("Success")
+
+	return apiRes.Items[0], nil
+}
+
 func getAPIKey(headers http.Header) (string, error) {
 	h := headers.Get("api_key")
 	if len(h) == 0 {
@@ -118,18 +161,42 @@ func getAPIKey(headers http.Header) (string, error) {
 	return h, nil
 }
 
-func hitAPI(url string) (*ChompResponse, error) {
-	resp, err := http.Get(url)
+// maxLoggedPayloadBytes caps how much of an upstream payload is logged.
+const maxLoggedPayloadBytes = 4 * 1024
+
+func (s *Service) hitAPI(ctx context.Context, url string) (*ChompResponse, error) {
+	log := logging.FromContext(ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request for Chomp API: %w", err)
+	}
+	resp, err := s.client.Do(req)
 	if err != nil {
+		// The URL contains the API key, so leave it out of the error.
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
 		return nil, fmt.Errorf("failed to execute HTTP request against Chomp API: %w", err)
 	}
+	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read HTTP response bytes from Chomp API: %w", err)
 	}
 
-	logrus.WithField("payload", string(b)).Info("Received response from Chomp API")
+	payload := b
+	if len(payload) > maxLoggedPayloadBytes {
+		payload = payload[:maxLoggedPayloadBytes]
+	}
+	log.WithFields(logrus.Fields{
+		"status":            resp.StatusCode,
+		"payload_bytes":     len(b),
+		"payload_truncated": len(payload) < len(b),
+		"payload":           string(payload),
+	}).Debug("Received response from Chomp API")
 
 	var res ChompResponse
 	err = json.Unmarshal(b, &res)
@@ -140,17 +207,54 @@ func hitAPI(url string) (*ChompResponse, error) {
 	return &res, nil
 }
 
-func convert(in ChompFoodItem) *chompv1beta1.Food {
+// convertOptions controls how Chomp's data is presented to clients.
+type convertOptions struct {
+	UnitSystem units.System
+	// DailyValues is the reference profile used to compute daily values. If
+	// it's the zero value, daily values are omitted.
+	DailyValues dailyvalue.Profile
+	// Allergens is the dictionary used to detect allergens in ingredients. If
+	// it's nil, only the allergens reported by Chomp are returned.
+	Allergens *allergens.Dictionary
+}
+
+// newConvertOptions returns the options for converting items in response to a
+// request with the given unit system and daily value profile.
+func (s *Service) newConvertOptions(sys chompv1beta1.UnitSystem, profileName string) (convertOptions, error) {
+	profile, err := s.dailyValueProfile(profileName)
+	if err != nil {
+		return convertOptions{}, err
+	}
+	return convertOptions{
+		UnitSystem:  unitSystem(sys),
+		DailyValues: profile,
+		Allergens:   s.allergens,
+	}, nil
+}
+
+func convert(in ChompFoodItem, opts convertOptions) *chompv1beta1.Food {
+	servingG, servingOK := servingGrams(in)
+	packageG, packageOK := packageGrams(in)
 	var nutrients []*chompv1beta1.Nutrient
 	for _, n := range in.Nutrients {
-		nutrients = append(nutrients, &chompv1beta1.Nutrient{
-			Name:            n.Name,
-			Per_100G:        int32(n.Per100G),
-			MeasurementUnit: n.MeasurementUnit,
-			Rank:            int32(n.Rank),
-			DataPoints:      int32(n.DataPoints),
-			Description:     n.Description,
-		})
+		nutrient := &chompv1beta1.Nutrient{
+			Name:                n.Name,
+			Per_100G:            int32(n.Per100G),
+			Per_100GValue:       float64(n.Per100G),
+			MeasurementUnit:     n.MeasurementUnit,
+			Rank:                int32(n.Rank),
+			DataPoints:          int32(n.DataPoints),
+			Description:         n.Description,
+			PerServingAvailable: servingOK,
+			PerPackageAvailable: packageOK,
+		}
+		if servingOK {
+			nutrient.PerServing = scalePer100G(float64(n.Per100G), servingG)
+		}
+		if packageOK {
+			nutrient.PerPackage = scalePer100G(float64(n.Per100G), packageG)
+		}
+		nutrients = append(nutrients, nutrient)
 	}
 	var dietFlags []*chompv1beta1.DietFlag
 	for _, d := range in.DietFlags {
@@ -164,7 +268,7 @@ func convert(in ChompFoodItem) *chompv1beta1.Food {
 			IsAllergen:               d.IsAllergen,
 		})
 	}
-	return &chompv1beta1.Food{
+	out := &chompv1beta1.Food{
 		Barcode:     in.Barcode,
 		Name:        in.Name,
 		Brand:       in.Brand,
@@ -236,7 +340,14 @@ func convert(in ChompFoodItem) *chompv1beta1.Food {
 		Vitamins:              in.Vitamins,
 		Description:           in.Description,
 		Keywords:              in.Keywords,
+		IngredientTree:        ingredientTree(ingredients.Parse(in.Ingredients)),
+		Additives:             detectAdditives(in),
 	}
+	localize(out, in, opts.UnitSystem)
+	addDailyValues(out, opts.DailyValues)
+	out.NutritionGrade = nutritionGrade(out)
+	detectAllergens(out, in, opts.Allergens)
+	return out
 }
 
 type ChompResponse struct {
@@ -260,7 +371,7 @@ type ChompFoodItem struct {
 	Categories []string `json:"categories"`
 	Nutrients  []struct {
 		Name            string `json:"name"`
-		Per100G         int    `json:"per_100g"`
+		Per100G         Number `json:"per_100g"`
 		MeasurementUnit string `json:"measurement_unit"`
 		Rank            int    `json:"rank"`
 		DataPoints      int    `json:"data_points"`
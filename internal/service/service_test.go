@@ -1,13 +1,26 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/allergens"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/dailyvalue"
+	chompv1beta1 "github.com/kevinmichaelchen/chomp-proxy/internal/gen/chomp/v1beta1"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/nutriscore"
+	"github.com/kevinmichaelchen/chomp-proxy/internal/units"
 	"github.com/stretchr/testify/require"
-	chompv1beta1 "go.buf.build/bufbuild/connect-go/kevinmichaelchen/chompapis/chomp/v1beta1"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/testing/protocmp"
 	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
 	"testing"
+	"time"
 )
 
 func TestGetAPIKey(t *testing.T) {
@@ -234,3 +247,601 @@ func TestUnmarshal(t *testing.T) {
 	diff := cmp.Diff(expected, &actual, protocmp.Transform())
 	require.Empty(t, diff)
 }
+
+func TestNumberUnmarshal(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected Number
+		wantErr  bool
+	}{
+		"integer":          {in: `12`, expected: 12},
+		"fraction":         {in: `0.4`, expected: 0.4},
+		"exponent":         {in: `1.5e-3`, expected: 0.0015},
+		"string":           {in: `"3.57"`, expected: 3.57},
+		"padded string":    {in: `" 21.43 "`, expected: 21.43},
+		"empty string":     {in: `""`, expected: 0},
+		"null":             {in: `null`, expected: 0},
+		"non-numeric text": {in: `"n/a"`, wantErr: true},
+		"boolean":          {in: `true`, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var actual Number
+			err := json.Unmarshal([]byte(tc.in), &actual)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.InDelta(t, float64(tc.expected), float64(actual), 1e-9)
+		})
+	}
+}
+
+func TestConvertFractionalNutrients(t *testing.T) {
+	// Trimmed down from a real Chomp response for a box of crackers.
+	s := `
+{
+  "items": [
+    {
+      "barcode": "0044000032029",
+      "name": "Original Crackers",
+      "nutrients": [
+        {
+          "name": "Sodium, Na",
+          "per_100g": 0.4,
+          "measurement_unit": "g",
+          "rank": 5,
+          "data_points": 1,
+          "description": "Calculated from value per serving size measure"
+        },
+        {
+          "name": "Protein",
+          "per_100g": "7.14",
+          "measurement_unit": "g",
+          "rank": 600,
+          "data_points": 3,
+          "description": "Calculated from value per serving size measure"
+        },
+        {
+          "name": "Energy",
+          "per_100g": 500,
+          "measurement_unit": "kcal",
+          "rank": 300,
+          "data_points": 3,
+          "description": "Calculated from value per serving size measure"
+        }
+      ]
+    }
+  ]
+}
+`
+
+	var res ChompResponse
+	err := json.Unmarshal([]byte(s), &res)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+
+	actual := convert(res.Items[0], convertOptions{}).GetNutrients()
+	expected := []*chompv1beta1.Nutrient{
+		{
+			Name:            "Sodium, Na",
+			Per_100G:        0,
+			Per_100GValue:   0.4,
+			MeasurementUnit: "g",
+			Rank:            5,
+			DataPoints:      1,
+			Description:     "Calculated from value per serving size measure",
+		},
+		{
+			Name:            "Protein",
+			Per_100G:        7,
+			Per_100GValue:   7.14,
+			MeasurementUnit: "g",
+			Rank:            600,
+			DataPoints:      3,
+			Description:     "Calculated from value per serving size measure",
+		},
+		{
+			Name:            "Energy",
+			Per_100G:        500,
+			Per_100GValue:   500,
+			MeasurementUnit: "kcal",
+			Rank:            300,
+			DataPoints:      3,
+			Description:     "Calculated from value per serving size measure",
+		},
+	}
+	diff := cmp.Diff(expected, actual, protocmp.Transform())
+	require.Empty(t, diff)
+}
+
+func TestConvertPerServingAndPackage(t *testing.T) {
+	tests := map[string]struct {
+		serving     string
+		packageSize string
+		perServing  float64
+		servingOK   bool
+		perPackage  float64
+		packageOK   bool
+	}{
+		"metric serving and imperial package": {
+			serving:     `{"size": "30", "measurement_unit": "g", "size_fulltext": "5 crackers (30 g)"}`,
+			packageSize: "8 oz (227 g)",
+			perServing:  3,
+			servingOK:   true,
+			perPackage:  22.6796185,
+			packageOK:   true,
+		},
+		"serving falls back to full text": {
+			serving:     `{"size": "5", "measurement_unit": "crackers", "size_fulltext": "5 crackers (30 g)"}`,
+			packageSize: "227 g",
+			perServing:  3,
+			servingOK:   true,
+			perPackage:  22.7,
+			packageOK:   true,
+		},
+		"volume serving of a beverage": {
+			serving:     `{"size": "1", "measurement_unit": "cup", "size_fulltext": "1 cup"}`,
+			packageSize: "64 fl oz",
+			perServing:  23.65882365,
+			servingOK:   true,
+			perPackage:  189.27058920000002,
+			packageOK:   true,
+		},
+		"volume serving of a solid": {
+			serving:     `{"size": "1", "measurement_unit": "cup", "size_fulltext": "1 cup"}`,
+			packageSize: "",
+		},
+		"mass preferred over volume": {
+			serving:     `{"size": "0.75", "measurement_unit": "cup", "size_fulltext": "3/4 cup (28 g)"}`,
+			packageSize: "12 oz",
+			perServing:  2.8,
+			servingOK:   true,
+			perPackage:  34.019427750000004,
+			packageOK:   true,
+		},
+		"unconvertible sizes": {
+			serving:     `{"size": "1", "measurement_unit": "slice", "size_fulltext": "1 slice"}`,
+			packageSize: "1 loaf",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := fmt.Sprintf(`{
+  "serving": %s,
+  "package": {"quantity": 1, "size": %q},
+  "nutrients": [{"name": "Protein", "per_100g": 10, "measurement_unit": "g"}]
+}`, tc.serving, tc.packageSize)
+
+			var item ChompFoodItem
+			err := json.Unmarshal([]byte(s), &item)
+			require.NoError(t, err)
+
+			n := convert(item, convertOptions{}).GetNutrients()[0]
+			require.Equal(t, tc.servingOK, n.GetPerServingAvailable())
+			require.InDelta(t, tc.perServing, n.GetPerServing(), 1e-6)
+			require.Equal(t, tc.packageOK, n.GetPerPackageAvailable())
+			require.InDelta(t, tc.perPackage, n.GetPerPackage(), 1e-6)
+		})
+	}
+}
+
+func TestConvertUnitSystem(t *testing.T) {
+	s := `
+{
+  "serving": {"size": "1", "measurement_unit": "oz", "size_fulltext": "1 oz (28 g)"},
+  "package": {"quantity": 1, "size": "12 oz (340 g)"},
+  "nutrients": [
+    {"name": "Energy", "per_100g": 400, "measurement_unit": "kcal"},
+    {"name": "Protein", "per_100g": 10, "measurement_unit": "g"}
+  ]
+}
+`
+	var item ChompFoodItem
+	err := json.Unmarshal([]byte(s), &item)
+	require.NoError(t, err)
+
+	asIs := convert(item, convertOptions{UnitSystem: units.AsIs})
+	require.Equal(t, "1", asIs.GetServing().GetSize())
+	require.Equal(t, "oz", asIs.GetServing().GetMeasurementUnit())
+	require.Equal(t, "12 oz (340 g)", asIs.GetPackage().GetSize())
+	require.Equal(t, "kcal", asIs.GetNutrients()[0].GetMeasurementUnit())
+
+	metric := convert(item, convertOptions{UnitSystem: units.Metric})
+	require.Equal(t, "28.35", metric.GetServing().GetSize())
+	require.Equal(t, "g", metric.GetServing().GetMeasurementUnit())
+	require.Equal(t, "1 oz (28 g)", metric.GetServing().GetSizeFulltext())
+	require.Equal(t, "340.19 g", metric.GetPackage().GetSize())
+
+	energy := metric.GetNutrients()[0]
+	require.Equal(t, "kJ", energy.GetMeasurementUnit())
+	require.InDelta(t, 1673.6, energy.GetPer_100GValue(), 1e-9)
+	require.EqualValues(t, 400, energy.GetPer_100G())
+	require.InDelta(t, 474.4576, energy.GetPerServing(), 1e-4)
+
+	protein := metric.GetNutrients()[1]
+	require.Equal(t, "g", protein.GetMeasurementUnit())
+	require.InDelta(t, 10, protein.GetPer_100GValue(), 1e-9)
+}
+
+func TestConvertDailyValues(t *testing.T) {
+	s := `
+{
+  "serving": {"size": "30", "measurement_unit": "g"},
+  "nutrients": [
+    {"name": "Sodium, Na", "per_100g": 0.4, "measurement_unit": "g"},
+    {"name": "Caffeine", "per_100g": 10, "measurement_unit": "mg"}
+  ]
+}
+`
+	var item ChompFoodItem
+	err := json.Unmarshal([]byte(s), &item)
+	require.NoError(t, err)
+
+	svc := NewService(dailyvalue.Builtin(), nil, nil)
+	profile, err := svc.dailyValueProfile("")
+	require.NoError(t, err)
+
+	nutrients := convert(item, convertOptions{DailyValues: profile}).GetNutrients()
+	expected := &chompv1beta1.DailyValue{
+		Profile:           "fda",
+		ReferenceAmount:   2300,
+		ReferenceUnit:     "mg",
+		Per_100GPercent:   400.0 / 2300 * 100,
+		PerServingPercent: 120.0 / 2300 * 100,
+	}
+	diff := cmp.Diff(expected, nutrients[0].GetDailyValue(), protocmp.Transform(), cmpopts.EquateApprox(0, 1e-9))
+	require.Empty(t, diff)
+	require.Nil(t, nutrients[1].GetDailyValue())
+
+	_, err = svc.dailyValueProfile("nope")
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestConvertNutritionGrade(t *testing.T) {
+	s := `
+{
+  "categories": ["Soda"],
+  "nutrients": [
+    {"name": "Energy", "per_100g": 43, "measurement_unit": "kcal"},
+    {"name": "Sugars, total", "per_100g": 10.6, "measurement_unit": "g"},
+    {"name": "Fatty acids, total saturated", "per_100g": 0, "measurement_unit": "g"},
+    {"name": "Sodium, Na", "per_100g": 4, "measurement_unit": "mg"}
+  ]
+}
+`
+	var item ChompFoodItem
+	err := json.Unmarshal([]byte(s), &item)
+	require.NoError(t, err)
+
+	grade := convert(item, convertOptions{}).GetNutritionGrade()
+	require.Equal(t, "E", grade.GetGrade())
+	require.Equal(t, "beverage", grade.GetCategory())
+	require.EqualValues(t, 14, grade.GetScore())
+	require.Len(t, grade.GetComponents(), 7)
+	require.Equal(t, "Graded E (score 14): penalized for sugars (8/10) and energy (6/10).", grade.GetExplanation())
+
+	// Without sodium, there isn't enough data to grade the item.
+	item.Nutrients = item.Nutrients[:3]
+	require.Nil(t, convert(item, convertOptions{}).GetNutritionGrade())
+}
+
+func TestNutritionCategory(t *testing.T) {
+	tests := map[string]struct {
+		categories []string
+		in         nutriscore.Input
+		expected   nutriscore.Category
+	}{
+		"plain water":    {categories: []string{"Bottled Water"}, expected: nutriscore.Water},
+		"flavored water": {categories: []string{"Water"}, in: nutriscore.Input{SugarsG: 5}, expected: nutriscore.Beverage},
+		"juice":          {categories: []string{"Fruit Juices"}, expected: nutriscore.Beverage},
+		"cheese":         {categories: []string{"Cheese"}, expected: nutriscore.Cheese},
+		"oil":            {categories: []string{"Vegetable & Cooking Oils"}, expected: nutriscore.Fat},
+		"butter":         {categories: []string{"Butter"}, expected: nutriscore.Fat},
+		"peanut butter":  {categories: []string{"Peanut Butter"}, expected: nutriscore.General},
+		"no categories":  {expected: nutriscore.General},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, nutritionCategory(tc.categories, tc.in))
+		})
+	}
+}
+
+func TestConvertIngredientTree(t *testing.T) {
+	item := ChompFoodItem{
+		Ingredients: "Chocolate (sugar, cocoa butter) 20%, milk. Contains 2% or less of: salt",
+	}
+	expected := []*chompv1beta1.Ingredient{
+		{
+			Name:             "Chocolate",
+			Percent:          20,
+			PercentAvailable: true,
+			Ingredients: []*chompv1beta1.Ingredient{
+				{Name: "sugar"},
+				{Name: "cocoa butter"},
+			},
+		},
+		{Name: "milk"},
+		{Name: "salt", MaxPercent: 2},
+	}
+	diff := cmp.Diff(expected, convert(item, convertOptions{}).GetIngredientTree(), protocmp.Transform())
+	require.Empty(t, diff)
+}
+
+func TestConvertAllergens(t *testing.T) {
+	item := ChompFoodItem{
+		Ingredients:    "Oats, sugar, whey powder, soy lecithin. May contain peanuts and tree nuts.",
+		IngredientList: []string{"Oats", "Sugar", "Whey powder", "Soy lecithin"},
+		Allergens:      []string{"Soy", "Oat"},
+		Traces:         []string{"Nuts"},
+	}
+
+	out := convert(item, convertOptions{Allergens: allergens.Builtin()})
+	require.Equal(t, []string{"Soy", "Oat", "milk"}, out.GetAllergens())
+	require.Equal(t, []string{"Nuts", "peanuts"}, out.GetTraces())
+
+	expected := []*chompv1beta1.AllergenFinding{
+		{Name: "soy", Provided: true, Inferred: true, MatchedTerms: []string{"soy"}, Regulations: []string{"fda", "eu"}},
+		{Name: "gluten", Provided: true, Inferred: true, MatchedTerms: []string{"oats"}, Regulations: []string{"eu"}},
+		{Name: "tree nuts", Provided: true, Inferred: true, Trace: true, MatchedTerms: []string{"tree nuts"}, Regulations: []string{"fda", "eu"}},
+		{Name: "milk", Inferred: true, MatchedTerms: []string{"whey"}, Regulations: []string{"fda", "eu"}},
+		{Name: "peanuts", Inferred: true, Trace: true, MatchedTerms: []string{"peanuts"}, Regulations: []string{"fda", "eu"}},
+	}
+	diff := cmp.Diff(expected, out.GetAllergenFindings(), protocmp.Transform())
+	require.Empty(t, diff)
+
+	// Chomp's item is left untouched.
+	require.Equal(t, []string{"Soy", "Oat"}, item.Allergens)
+
+	// Without a dictionary, only Chomp's allergens are returned.
+	out = convert(item, convertOptions{})
+	require.Equal(t, []string{"Soy", "Oat"}, out.GetAllergens())
+	require.Empty(t, out.GetAllergenFindings())
+}
+
+func TestConvertAllergensImplied(t *testing.T) {
+	tests := map[string]struct {
+		allergens []string
+		traces    []string
+		expected  []*chompv1beta1.AllergenFinding
+		// expectedAllergens and expectedTraces are the food's merged lists.
+		expectedAllergens []string
+		expectedTraces    []string
+	}{
+		"allergen implies another": {
+			allergens: []string{"Wheat"},
+			expected: []*chompv1beta1.AllergenFinding{
+				{Name: "wheat", Provided: true, Regulations: []string{"fda"}},
+				{Name: "gluten", Inferred: true, MatchedTerms: []string{"wheat"}, Regulations: []string{"eu"}},
+			},
+			expectedAllergens: []string{"Wheat", "gluten"},
+		},
+		"trace implies another": {
+			traces: []string{"wheat"},
+			expected: []*chompv1beta1.AllergenFinding{
+				{Name: "wheat", Provided: true, Trace: true, Regulations: []string{"fda"}},
+				{Name: "gluten", Inferred: true, Trace: true, MatchedTerms: []string{"wheat"}, Regulations: []string{"eu"}},
+			},
+			expectedTraces: []string{"wheat", "gluten"},
+		},
+		"both reported": {
+			allergens: []string{"Wheat", "Gluten"},
+			expected: []*chompv1beta1.AllergenFinding{
+				{Name: "wheat", Provided: true, Regulations: []string{"fda"}},
+				{Name: "gluten", Provided: true, Inferred: true, MatchedTerms: []string{"wheat"}, Regulations: []string{"eu"}},
+			},
+			expectedAllergens: []string{"Wheat", "Gluten"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			item := ChompFoodItem{Allergens: tc.allergens, Traces: tc.traces}
+			out := convert(item, convertOptions{Allergens: allergens.Builtin()})
+			diff := cmp.Diff(tc.expected, out.GetAllergenFindings(), protocmp.Transform())
+			require.Empty(t, diff)
+			require.Equal(t, tc.expectedAllergens, out.GetAllergens())
+			require.Equal(t, tc.expectedTraces, out.GetTraces())
+		})
+	}
+}
+
+func TestConvertAdditives(t *testing.T) {
+	item := ChompFoodItem{
+		Ingredients: "Carbonated water, sugar, colour (caramel E150d), phosphoric acid, natural flavourings, " +
+			"caffeine, sweetener (aspartame).",
+	}
+
+	expected := []*chompv1beta1.Additive{
+		{
+			Code:          "E150d",
+			Name:          "Sulphite ammonia caramel",
+			FunctionClass: "colour",
+			RiskNotes:     "May contain 4-methylimidazole, a by-product of its manufacture.",
+			MatchedTerms:  []string{"E150d"},
+		},
+		{
+			Code:          "E338",
+			Name:          "Phosphoric acid",
+			FunctionClass: "acidity regulator",
+			RiskNotes:     "High phosphate intake is associated with lower bone density and is a concern for people with kidney disease.",
+			MatchedTerms:  []string{"phosphoric acid"},
+		},
+		{
+			Code:          "E951",
+			Name:          "Aspartame",
+			FunctionClass: "sweetener",
+			RiskNotes:     "Contains a source of phenylalanine, so unsuitable for people with phenylketonuria. Classified by IARC as possibly carcinogenic to humans (group 2B).",
+			MatchedTerms:  []string{"aspartame"},
+		},
+	}
+	out := convert(item, convertOptions{})
+	diff := cmp.Diff(expected, out.GetAdditives(), protocmp.Transform())
+	require.Empty(t, diff)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestHitAPIErrorOmitsAPIKey(t *testing.T) {
+	client := &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		}),
+	}
+	svc := NewService(dailyvalue.Builtin(), nil, client)
+
+	_, err := svc.hitAPI(context.Background(), "https://chompthis.com/api/v2/food/branded/barcode.php?api_key=secret&code=1")
+	require.ErrorContains(t, err, "connection refused")
+	require.NotContains(t, err.Error(), "secret")
+}
+
+// newUpstream starts a fake Chomp API and returns a client that sends every
+// request to it.
+func newUpstream(t *testing.T, handler http.HandlerFunc) *http.Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	target, err := neturl.Parse(srv.URL)
+	require.NoError(t, err)
+	return &http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+}
+
+func TestGetFood(t *testing.T) {
+	tests := map[string]struct {
+		apiKey   string
+		body     string
+		wantCode connect.Code
+		wantName string
+	}{
+		"found": {
+			apiKey:   "secret",
+			body:     `{"items": [{"barcode": "0123", "name": "Oat Bar", "serving": {"size": "40", "measurement_unit": "g"}, "nutrients": [{"name": "Energy", "per_100g": 400, "measurement_unit": "kcal"}]}]}`,
+			wantName: "Oat Bar",
+		},
+		"not found": {
+			apiKey:   "secret",
+			body:     `{"items": []}`,
+			wantCode: connect.CodeNotFound,
+		},
+		"missing API key": {
+			wantCode: connect.CodePermissionDenied,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newUpstream(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/api/v2/food/branded/barcode.php", r.URL.Path)
+				require.Equal(t, "0123", r.URL.Query().Get("code"))
+				require.Equal(t, tc.apiKey, r.URL.Query().Get("api_key"))
+				fmt.Fprint(w, tc.body)
+			})
+			svc := NewService(dailyvalue.Builtin(), nil, client)
+
+			req := connect.NewRequest(&chompv1beta1.GetFoodRequest{
+				Code:       "0123",
+				UnitSystem: chompv1beta1.UnitSystem_UNIT_SYSTEM_METRIC,
+			})
+			if tc.apiKey != "" {
+				req.Header().Set("api_key", tc.apiKey)
+			}
+			res, err := svc.GetFood(context.Background(), req)
+			if tc.wantCode != 0 {
+				require.Equal(t, tc.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "v1beta1", res.Header().Get("API-Version"))
+
+			food := res.Msg.GetFood()
+			require.Equal(t, tc.wantName, food.GetName())
+			require.Equal(t, "0123", food.GetBarcode())
+			energy := food.GetNutrients()[0]
+			require.Equal(t, "kJ", energy.GetMeasurementUnit())
+			require.InDelta(t, 1673.6, energy.GetPer_100GValue(), 1e-9)
+			require.EqualValues(t, 400, energy.GetPer_100G())
+			require.True(t, energy.GetPerServingAvailable())
+		})
+	}
+}
+
+func TestPing(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		err     error
+		wantErr string
+	}{
+		"ok":          {status: http.StatusOK},
+		"not found":   {status: http.StatusNotFound},
+		"revoked key": {status: http.StatusUnauthorized, wantErr: "rejected the API key"},
+		"unavailable": {status: http.StatusBadGateway, wantErr: "unavailable"},
+		"unreachable": {err: errors.New("connection refused"), wantErr: "connection refused"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					if tc.err != nil {
+						return nil, tc.err
+					}
+					return &http.Response{
+						StatusCode: tc.status,
+						Status:     http.StatusText(tc.status),
+						Body:       http.NoBody,
+					}, nil
+				}),
+			}
+			svc := NewService(dailyvalue.Builtin(), nil, client)
+
+			err := svc.Ping(context.Background(), "secret")
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+			require.NotContains(t, err.Error(), "secret")
+		})
+	}
+}
+
+func TestPingReusesResult(t *testing.T) {
+	var lookups int
+	status := http.StatusOK
+	svc := NewService(dailyvalue.Builtin(), nil, newUpstream(t, func(w http.ResponseWriter, r *http.Request) {
+		lookups++
+		w.WriteHeader(status)
+	}))
+
+	require.NoError(t, svc.Ping(context.Background(), "secret"))
+	require.NoError(t, svc.Ping(context.Background(), "secret"))
+	require.Equal(t, 1, lookups)
+
+	// Another key is looked up.
+	require.NoError(t, svc.Ping(context.Background(), "other"))
+	require.Equal(t, 2, lookups)
+
+	// Failures are reused too, until the result expires.
+	status = http.StatusUnauthorized
+	svc.lastPing.checkedAt = time.Now().Add(-pingTTL)
+	require.ErrorContains(t, svc.Ping(context.Background(), "other"), "rejected the API key")
+	require.ErrorContains(t, svc.Ping(context.Background(), "other"), "rejected the API key")
+	require.Equal(t, 3, lookups)
+
+	// A ping the caller gave up on isn't reused.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	svc.lastPing.checkedAt = time.Now().Add(-pingTTL)
+	require.Error(t, svc.Ping(ctx, "other"))
+	status = http.StatusOK
+	require.NoError(t, svc.Ping(context.Background(), "other"))
+	require.Equal(t, 4, lookups)
+}
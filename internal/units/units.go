@@ -0,0 +1,340 @@
+// Package units parses the free-form quantities found on food labels (e.g.
+// "12 oz (340 g)" or "1/2 cup"), normalizes them to grams, and converts them
+// between metric and imperial units.
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dimension is the physical dimension a unit measures.
+type Dimension int
+
+const (
+	Mass Dimension = iota + 1
+	Volume
+	Energy
+)
+
+// Unit is a unit of measurement, defined relative to the base unit of its
+// dimension (grams for mass, milliliters for volume, kilocalories for energy).
+type Unit struct {
+	Symbol    string
+	Dimension Dimension
+	// Factor converts one of this unit into the base unit of its dimension.
+	Factor float64
+}
+
+var (
+	Gram       = Unit{Symbol: "g", Dimension: Mass, Factor: 1}
+	Milligram  = Unit{Symbol: "mg", Dimension: Mass, Factor: 0.001}
+	Microgram  = Unit{Symbol: "mcg", Dimension: Mass, Factor: 0.000001}
+	Kilogram   = Unit{Symbol: "kg", Dimension: Mass, Factor: 1000}
+	Ounce      = Unit{Symbol: "oz", Dimension: Mass, Factor: 28.349523125}
+	Pound      = Unit{Symbol: "lb", Dimension: Mass, Factor: 453.59237}
+	Milliliter = Unit{Symbol: "ml", Dimension: Volume, Factor: 1}
+	Centiliter = Unit{Symbol: "cl", Dimension: Volume, Factor: 10}
+	Liter      = Unit{Symbol: "l", Dimension: Volume, Factor: 1000}
+	FluidOunce = Unit{Symbol: "fl oz", Dimension: Volume, Factor: 29.5735295625}
+	Teaspoon   = Unit{Symbol: "tsp", Dimension: Volume, Factor: 4.92892159375}
+	Tablespoon = Unit{Symbol: "tbsp", Dimension: Volume, Factor: 14.78676478125}
+	Cup        = Unit{Symbol: "cup", Dimension: Volume, Factor: 236.5882365}
+	Pint       = Unit{Symbol: "pt", Dimension: Volume, Factor: 473.176473}
+	Quart      = Unit{Symbol: "qt", Dimension: Volume, Factor: 946.352946}
+	Gallon     = Unit{Symbol: "gal", Dimension: Volume, Factor: 3785.411784}
+
+	Kilocalorie = Unit{Symbol: "kcal", Dimension: Energy, Factor: 1}
+	Kilojoule   = Unit{Symbol: "kJ", Dimension: Energy, Factor: 1 / 4.184}
+)
+
+// aliases maps every spelling we've seen on labels, including the UN/CEFACT
+// codes Chomp sometimes uses (e.g. GRM, ONZ, MLT), to a Unit.
+var aliases = map[string]Unit{
+	"g":           Gram,
+	"gr":          Gram,
+	"gram":        Gram,
+	"grams":       Gram,
+	"grm":         Gram,
+	"mg":          Milligram,
+	"milligram":   Milligram,
+	"milligrams":  Milligram,
+	"mgm":         Milligram,
+	"mcg":         Microgram,
+	"µg":          Microgram,
+	"ug":          Microgram,
+	"microgram":   Microgram,
+	"micrograms":  Microgram,
+	"kg":          Kilogram,
+	"kilogram":    Kilogram,
+	"kilograms":   Kilogram,
+	"kgm":         Kilogram,
+	"oz":          Ounce,
+	"ounce":       Ounce,
+	"ounces":      Ounce,
+	"onz":         Ounce,
+	"lb":          Pound,
+	"lbs":         Pound,
+	"pound":       Pound,
+	"pounds":      Pound,
+	"lbr":         Pound,
+	"ml":          Milliliter,
+	"milliliter":  Milliliter,
+	"milliliters": Milliliter,
+	"millilitre":  Milliliter,
+	"millilitres": Milliliter,
+	"mlt":         Milliliter,
+	"cl":          Centiliter,
+	"l":           Liter,
+	"liter":       Liter,
+	"liters":      Liter,
+	"litre":       Liter,
+	"litres":      Liter,
+	"ltr":         Liter,
+	"fl oz":       FluidOunce,
+	"floz":        FluidOunce,
+	"fl. oz":      FluidOunce,
+	"fluid ounce": FluidOunce,
+	"oza":         FluidOunce,
+	"tsp":         Teaspoon,
+	"teaspoon":    Teaspoon,
+	"teaspoons":   Teaspoon,
+	"tbsp":        Tablespoon,
+	"tbs":         Tablespoon,
+	"tablespoon":  Tablespoon,
+	"tablespoons": Tablespoon,
+	"cup":         Cup,
+	"cups":        Cup,
+	"pt":          Pint,
+	"pint":        Pint,
+	"pints":       Pint,
+	"qt":          Quart,
+	"quart":       Quart,
+	"quarts":      Quart,
+	"gal":         Gallon,
+	"gallon":      Gallon,
+	"gallons":     Gallon,
+
+	"kcal":         Kilocalorie,
+	"kilocalorie":  Kilocalorie,
+	"kilocalories": Kilocalorie,
+	"kj":           Kilojoule,
+	"kilojoule":    Kilojoule,
+	"kilojoules":   Kilojoule,
+}
+
+// LookupUnit returns the Unit for a label spelling such as "Grams" or "ONZ".
+func LookupUnit(s string) (Unit, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimSuffix(s, ".")
+	u, ok := aliases[s]
+	return u, ok
+}
+
+// Quantity is an amount expressed in some unit.
+type Quantity struct {
+	Value float64
+	Unit  Unit
+}
+
+// Base returns the quantity expressed in the base unit of its dimension.
+func (q Quantity) Base() float64 {
+	return q.Value * q.Unit.Factor
+}
+
+// String formats the quantity the way it would appear on a label, rounded to
+// at most two decimal places.
+func (q Quantity) String() string {
+	return FormatValue(q.Value) + " " + q.Unit.Symbol
+}
+
+// FormatValue formats an amount rounded to at most two decimal places.
+func FormatValue(v float64) string {
+	return strconv.FormatFloat(Round(v, 2), 'f', -1, 64)
+}
+
+// ErrUnknownUnit is returned when a quantity's unit isn't one we can convert,
+// e.g. "2 crackers" or "1 slice".
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// quantityPattern matches a leading amount (integer, decimal, fraction or
+// mixed number) followed by a unit.
+var quantityPattern = regexp.MustCompile(`^\s*(\d+\s+\d+/\d+|\d+/\d+|\d*\.?\d+)\s*([a-zA-Zµ][a-zA-Zµ. ]*?)\s*$`)
+
+// Parse parses a quantity like "28 g", "1.5oz", "1/2 cup" or "1 1/4 fl oz".
+func Parse(s string) (Quantity, error) {
+	m := quantityPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Quantity{}, fmt.Errorf("unrecognized quantity %q", s)
+	}
+	v, err := parseAmount(m[1])
+	if err != nil {
+		return Quantity{}, err
+	}
+	u, ok := LookupUnit(m[2])
+	if !ok {
+		return Quantity{}, fmt.Errorf("%w %q", ErrUnknownUnit, m[2])
+	}
+	return Quantity{Value: v, Unit: u}, nil
+}
+
+// parenthesized matches the contents of each pair of parentheses.
+var parenthesized = regexp.MustCompile(`\(([^()]*)\)`)
+
+// ParseLabel parses a label quantity that may carry an alternate measure in
+// parentheses, such as "12 oz (340 g)" or "2 crackers (30 g)". A measure of
+// mass is preferred, so "3/4 cup (28 g)" is 28 g; otherwise the leading
+// measure is preferred, then the parenthesized ones in order.
+func ParseLabel(s string) (Quantity, error) {
+	var measures []Quantity
+	lead := parenthesized.ReplaceAllString(s, "")
+	q, err := Parse(lead)
+	if err == nil {
+		measures = append(measures, q)
+	}
+	for _, m := range parenthesized.FindAllStringSubmatch(s, -1) {
+		if alt, altErr := Parse(m[1]); altErr == nil {
+			measures = append(measures, alt)
+		}
+	}
+	if len(measures) == 0 {
+		return Quantity{}, err
+	}
+	return PreferMass(measures), nil
+}
+
+// PreferMass returns the first measure of mass, or the first measure if
+// there's none.
+func PreferMass(measures []Quantity) Quantity {
+	for _, m := range measures {
+		if m.Unit.Dimension == Mass {
+			return m
+		}
+	}
+	return measures[0]
+}
+
+func parseAmount(s string) (float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 2 {
+		whole, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, err
+		}
+		frac, err := parseAmount(fields[1])
+		if err != nil {
+			return 0, err
+		}
+		return whole + frac, nil
+	}
+	if num, den, ok := strings.Cut(s, "/"); ok {
+		n, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			return 0, err
+		}
+		d, err := strconv.ParseFloat(den, 64)
+		if err != nil {
+			return 0, err
+		}
+		if d == 0 {
+			return 0, fmt.Errorf("invalid fraction %q", s)
+		}
+		return n / d, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// Grams returns the weight of a quantity in grams. Volumes are converted
+// assuming the density of water, which matches how nutrient values are
+// reported per 100 ml for beverages. It's wrong for solids, e.g. a cup of
+// cereal.
+func Grams(q Quantity) (float64, bool) {
+	switch q.Unit.Dimension {
+	case Mass, Volume:
+		return q.Base(), true
+	default:
+		return 0, false
+	}
+}
+
+// ErrIncompatibleUnits is returned when converting between units of different
+// dimensions, e.g. grams to kilocalories.
+var ErrIncompatibleUnits = errors.New("incompatible units")
+
+// Convert expresses a quantity in another unit of the same dimension.
+func Convert(q Quantity, to Unit) (Quantity, error) {
+	if q.Unit.Dimension != to.Dimension {
+		return Quantity{}, fmt.Errorf("%w: %s to %s", ErrIncompatibleUnits, q.Unit.Symbol, to.Symbol)
+	}
+	if q.Unit == to {
+		return q, nil
+	}
+	return Quantity{Value: q.Base() / to.Factor, Unit: to}, nil
+}
+
+// System is a system of measurement that quantities can be displayed in.
+type System int
+
+const (
+	// AsIs leaves quantities in whatever unit they were reported in.
+	AsIs System = iota
+	Metric
+	Imperial
+)
+
+// Preferred returns the unit a system displays quantities of the given unit
+// in. For example, ounces are displayed as grams in the metric system.
+func Preferred(u Unit, sys System) Unit {
+	switch sys {
+	case Metric:
+		switch u.Dimension {
+		case Mass:
+			if u == Milligram || u == Microgram || u == Kilogram {
+				return u
+			}
+			return Gram
+		case Volume:
+			if u == Liter || u == Centiliter {
+				return u
+			}
+			return Milliliter
+		case Energy:
+			return Kilojoule
+		}
+	case Imperial:
+		switch u.Dimension {
+		case Mass:
+			if u == Pound {
+				return u
+			}
+			return Ounce
+		case Volume:
+			if u == Cup || u == Tablespoon || u == Teaspoon || u == Pint || u == Quart || u == Gallon {
+				return u
+			}
+			return FluidOunce
+		case Energy:
+			return Kilocalorie
+		}
+	}
+	return u
+}
+
+// In converts a quantity to its preferred unit in the given system.
+func In(q Quantity, sys System) Quantity {
+	converted, err := Convert(q, Preferred(q.Unit, sys))
+	if err != nil {
+		return q
+	}
+	return converted
+}
+
+// Round rounds a value to the given number of decimal places.
+func Round(v float64, places int) float64 {
+	p := math.Pow(10, float64(places))
+	return math.Round(v*p) / p
+}
@@ -0,0 +1,136 @@
+package units
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		in    string
+		value float64
+		unit  Unit
+	}{
+		"grams":              {in: "28 g", value: 28, unit: Gram},
+		"no space":           {in: "12oz", value: 12, unit: Ounce},
+		"decimal":            {in: "1.5 Cups", value: 1.5, unit: Cup},
+		"leading decimal":    {in: ".5 lb", value: 0.5, unit: Pound},
+		"fraction":           {in: "1/2 cup", value: 0.5, unit: Cup},
+		"mixed number":       {in: "1 1/4 fl oz", value: 1.25, unit: FluidOunce},
+		"chomp code":         {in: "355 MLT", value: 355, unit: Milliliter},
+		"trailing period":    {in: "2 tbsp.", value: 2, unit: Tablespoon},
+		"fluid ounce code":   {in: "12 OZA", value: 12, unit: FluidOunce},
+		"spelled out metric": {in: "2 litres", value: 2, unit: Liter},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			q, err := Parse(tc.in)
+			require.NoError(t, err)
+			require.InDelta(t, tc.value, q.Value, 1e-9)
+			require.Equal(t, tc.unit, q.Unit)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("2 crackers")
+	require.ErrorIs(t, err, ErrUnknownUnit)
+
+	_, err = Parse("28")
+	require.Error(t, err)
+
+	_, err = Parse("1/0 cup")
+	require.Error(t, err)
+}
+
+func TestParseLabel(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected Quantity
+	}{
+		"leading measure preferred": {
+			in:       "12 oz (340 g)",
+			expected: Quantity{Value: 12, Unit: Ounce},
+		},
+		"mass preferred over volume": {
+			in:       "3/4 cup (28 g)",
+			expected: Quantity{Value: 28, Unit: Gram},
+		},
+		"volume without mass": {
+			in:       "8 fl oz (240 ml)",
+			expected: Quantity{Value: 8, Unit: FluidOunce},
+		},
+		"falls back to parenthesized measure": {
+			in:       "2 crackers (30 g)",
+			expected: Quantity{Value: 30, Unit: Gram},
+		},
+		"skips unparseable parentheses": {
+			in:       "1 bar (large) (45 g)",
+			expected: Quantity{Value: 45, Unit: Gram},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			q, err := ParseLabel(tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, q)
+		})
+	}
+
+	_, err := ParseLabel("1 slice (about a handful)")
+	require.Error(t, err)
+}
+
+func TestGrams(t *testing.T) {
+	g, ok := Grams(Quantity{Value: 1, Unit: Pound})
+	require.True(t, ok)
+	require.InDelta(t, 453.59237, g, 1e-9)
+
+	g, ok = Grams(Quantity{Value: 1, Unit: Cup})
+	require.True(t, ok)
+	require.InDelta(t, 236.5882365, g, 1e-9)
+
+	_, ok = Grams(Quantity{Value: 1})
+	require.False(t, ok)
+}
+
+func TestConvert(t *testing.T) {
+	q, err := Convert(Quantity{Value: 8, Unit: Ounce}, Gram)
+	require.NoError(t, err)
+	require.InDelta(t, 226.796185, q.Value, 1e-6)
+	require.Equal(t, Gram, q.Unit)
+
+	q, err = Convert(Quantity{Value: 100, Unit: Kilocalorie}, Kilojoule)
+	require.NoError(t, err)
+	require.InDelta(t, 418.4, q.Value, 1e-9)
+
+	q, err = Convert(Quantity{Value: 355, Unit: Milliliter}, FluidOunce)
+	require.NoError(t, err)
+	require.InDelta(t, 12.0039, q.Value, 1e-4)
+
+	_, err = Convert(Quantity{Value: 1, Unit: Gram}, Kilocalorie)
+	require.ErrorIs(t, err, ErrIncompatibleUnits)
+}
+
+func TestIn(t *testing.T) {
+	tests := map[string]struct {
+		in       Quantity
+		sys      System
+		expected string
+	}{
+		"ounces to grams":           {in: Quantity{Value: 12, Unit: Ounce}, sys: Metric, expected: "340.19 g"},
+		"grams to ounces":           {in: Quantity{Value: 28, Unit: Gram}, sys: Imperial, expected: "0.99 oz"},
+		"fluid ounces to ml":        {in: Quantity{Value: 12, Unit: FluidOunce}, sys: Metric, expected: "354.88 ml"},
+		"cups kept in imperial":     {in: Quantity{Value: 1, Unit: Cup}, sys: Imperial, expected: "1 cup"},
+		"cups to ml":                {in: Quantity{Value: 1, Unit: Cup}, sys: Metric, expected: "236.59 ml"},
+		"kcal to kJ":                {in: Quantity{Value: 100, Unit: Kilocalorie}, sys: Metric, expected: "418.4 kJ"},
+		"kJ to kcal":                {in: Quantity{Value: 418.4, Unit: Kilojoule}, sys: Imperial, expected: "100 kcal"},
+		"milligrams kept in metric": {in: Quantity{Value: 5, Unit: Milligram}, sys: Metric, expected: "5 mg"},
+		"as-is":                     {in: Quantity{Value: 12, Unit: Ounce}, sys: AsIs, expected: "12 oz"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, In(tc.in, tc.sys).String())
+		})
+	}
+}
@@ -1,15 +1,44 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"github.com/kevinmichaelchen/chomp-proxy/internal/app"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/config"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/logging"
 	"go.uber.org/fx"
+	"os"
 )
 
 func main() {
+	var opts config.Options
+	flag.StringVar(&opts.Path, "config", os.Getenv("CONFIG_FILE"), "YAML or TOML config file; environment variables take precedence")
+	printConfig := flag.Bool("print-config", false, "print the effective configuration, with secrets redacted, and exit")
+	flag.Parse()
+
+	var src *config.Source
 	a := fx.New(
 		app.Module,
-		// TODO configure logrus
-		// For details, see https://github.com/uber-go/fx/blob/master/fxevent/zap.go
+		config.Module,
+		fx.Supply(opts),
+		fx.WithLogger(logging.NewFxLogger),
+		fx.StopTimeout(modConnect.StopTimeout),
+		// Runs after every module has read its config.
+		fx.Invoke(config.CheckUnknownKeys),
+		fx.Populate(&src),
 	)
+
+	if *printConfig {
+		if err := a.Err(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := src.Print(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	a.Run()
 }
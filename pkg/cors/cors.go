@@ -1,17 +1,75 @@
 package cors
 
 import (
+	"errors"
+	"fmt"
 	"github.com/rs/cors"
 	"net/http"
+	"strings"
 	"time"
 )
 
-// NewCORS returns a CORS HTTP handler.
+// Config is the CORS policy.
+type Config struct {
+	// AllowedOrigins are the origins browsers may call the server from. An
+	// origin may contain one wildcard, e.g. "https://*.example.com". "*", the
+	// default, allows every origin, so tools like Buf Studio can call the
+	// server. If it's set but empty, browsers can't call the server from other
+	// origins.
+	AllowedOrigins []string `env:"ALLOWED_ORIGINS,default=*"`
+	// AllowedHeaders are the request headers browsers may send. "*" allows
+	// every header.
+	AllowedHeaders []string `env:"ALLOWED_HEADERS,default=*"`
+	// ExposedHeaders are response headers exposed to browsers, in addition
+	// to the protocol's headers and those the server adds.
+	ExposedHeaders []string `env:"EXPOSED_HEADERS"`
+	// AllowCredentials lets browsers send cookies and HTTP authentication.
+	// It can't be combined with allowing every origin.
+	AllowCredentials bool `env:"ALLOW_CREDENTIALS"`
+	// MaxAge is how long browsers may cache preflight responses. Any changes
+	// to ExposedHeaders won't take effect until the cached data expires. FF
+	// caps this value at 24h, and modern Chrome caps it at 2h.
+	MaxAge time.Duration `env:"MAX_AGE,default=2h"`
+}
+
+// protocolHeaders are the Connect, gRPC and gRPC-Web response headers
+// browsers need to read.
+var protocolHeaders = []string{
+	// Content-Type is in the default safelist.
+	"Accept",
+	"Accept-Encoding",
+	"Accept-Post",
+	"Connect-Accept-Encoding",
+	"Connect-Content-Encoding",
+	"Content-Encoding",
+	"Grpc-Accept-Encoding",
+	"Grpc-Encoding",
+	"Grpc-Message",
+	"Grpc-Status",
+	"Grpc-Status-Details-Bin",
+}
+
+// NewCORS returns a CORS HTTP handler. The protocol's headers and
+// serverHeaders, the custom headers the server adds to responses, are always
+// exposed.
 // From here: https://github.com/bufbuild/connect-demo/blob/5889de5ab3c719e2acd7d5eb5c7802a9c3cc8dd0/main.go#L88
-func NewCORS() *cors.Cors {
-	// To let web developers play with the demo service from browsers, we need a
-	// very permissive CORS setup.
-	return cors.New(cors.Options{
+func NewCORS(cfg Config, serverHeaders ...string) (*cors.Cors, error) {
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" && cfg.AllowCredentials {
+			return nil, errors.New("CORS credentials can't be allowed for every origin")
+		}
+		if strings.Count(origin, "*") > 1 {
+			return nil, fmt.Errorf("CORS origin %q has more than one wildcard", origin)
+		}
+	}
+
+	var exposed []string
+	exposed = append(exposed, protocolHeaders...)
+	exposed = append(exposed, serverHeaders...)
+	exposed = append(exposed, cfg.ExposedHeaders...)
+
+	opts := cors.Options{
+		AllowedOrigins: cfg.AllowedOrigins,
 		AllowedMethods: []string{
 			http.MethodHead,
 			http.MethodGet,
@@ -20,29 +78,17 @@ func NewCORS() *cors.Cors {
 			http.MethodPatch,
 			http.MethodDelete,
 		},
-		AllowOriginFunc: func(origin string) bool {
-			// Allow all origins, which effectively disables CORS.
-			return true
-		},
-		AllowedHeaders: []string{"*"},
-		ExposedHeaders: []string{
-			// Content-Type is in the default safelist.
-			"Accept",
-			"Accept-Encoding",
-			"Accept-Post",
-			"Connect-Accept-Encoding",
-			"Connect-Content-Encoding",
-			"Content-Encoding",
-			"Grpc-Accept-Encoding",
-			"Grpc-Encoding",
-			"Grpc-Message",
-			"Grpc-Status",
-			"Grpc-Status-Details-Bin",
-		},
-		// Let browsers cache CORS information for longer, which reduces the number
-		// of preflight requests. Any changes to ExposedHeaders won't take effect
-		// until the cached data expires. FF caps this value at 24h, and modern
-		// Chrome caps it at 2h.
-		MaxAge: int(2 * time.Hour / time.Second),
-	})
+		AllowedHeaders:   cfg.AllowedHeaders,
+		ExposedHeaders:   exposed,
+		AllowCredentials: cfg.AllowCredentials,
+		// Let browsers cache CORS information for longer, which reduces the
+		// number of preflight requests.
+		MaxAge: int(cfg.MaxAge / time.Second),
+	}
+	if len(cfg.AllowedOrigins) == 0 {
+		// rs/cors allows every origin if none are given, unless there's a
+		// function to check them.
+		opts.AllowOriginFunc = func(string) bool { return false }
+	}
+	return cors.New(opts), nil
 }
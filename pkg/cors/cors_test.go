@@ -0,0 +1,136 @@
+package cors
+
+import (
+	"context"
+	"github.com/sethvargo/go-envconfig"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewCORS(t *testing.T) {
+	tests := map[string]struct {
+		cfg     Config
+		origin  string
+		allowed bool
+		wantErr string
+	}{
+		"no origins": {
+			cfg:    Config{},
+			origin: "https://app.example.com",
+		},
+		"listed origin": {
+			cfg:     Config{AllowedOrigins: []string{"https://app.example.com"}},
+			origin:  "https://app.example.com",
+			allowed: true,
+		},
+		"unlisted origin": {
+			cfg:    Config{AllowedOrigins: []string{"https://app.example.com"}},
+			origin: "https://evil.example.org",
+		},
+		"wildcard subdomain": {
+			cfg:     Config{AllowedOrigins: []string{"https://*.example.com"}},
+			origin:  "https://app.example.com",
+			allowed: true,
+		},
+		"every origin": {
+			cfg:     Config{AllowedOrigins: []string{"*"}},
+			origin:  "https://evil.example.org",
+			allowed: true,
+		},
+		"credentials for listed origins": {
+			cfg:     Config{AllowedOrigins: []string{"https://app.example.com"}, AllowCredentials: true},
+			origin:  "https://app.example.com",
+			allowed: true,
+		},
+		"credentials for every origin": {
+			cfg:     Config{AllowedOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true},
+			wantErr: "can't be allowed for every origin",
+		},
+		"several wildcards": {
+			cfg:     Config{AllowedOrigins: []string{"https://*.*.example.com"}},
+			wantErr: "more than one wildcard",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewCORS(tc.cfg, "X-Request-Id")
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			h := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			req := httptest.NewRequest(http.MethodPost, "/chomp.v1beta1.ChompService/GetFood", nil)
+			req.Header.Set("Origin", tc.origin)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			allowOrigin := rec.Header().Get("Access-Control-Allow-Origin")
+			if !tc.allowed {
+				require.Empty(t, allowOrigin)
+				return
+			}
+			require.NotEmpty(t, allowOrigin)
+			require.Equal(t, tc.cfg.AllowCredentials, rec.Header().Get("Access-Control-Allow-Credentials") == "true")
+		})
+	}
+}
+
+func TestNewCORSExposedHeaders(t *testing.T) {
+	c, err := NewCORS(Config{
+		AllowedOrigins: []string{"https://app.example.com"},
+		ExposedHeaders: []string{"X-Custom"},
+	}, "X-Request-Id", "X-Error-Id")
+	require.NoError(t, err)
+
+	h := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	req := httptest.NewRequest(http.MethodPost, "/chomp.v1beta1.ChompService/GetFood", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	exposed := rec.Header().Get("Access-Control-Expose-Headers")
+	for _, header := range []string{"Grpc-Status", "Grpc-Message", "X-Request-Id", "X-Error-Id", "X-Custom"} {
+		require.Contains(t, exposed, header)
+	}
+}
+
+func TestNewCORSPreflight(t *testing.T) {
+	c, err := NewCORS(Config{AllowedOrigins: []string{"https://app.example.com"}, AllowedHeaders: []string{"*"}, MaxAge: 2 * time.Hour})
+	require.NoError(t, err)
+
+	h := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("preflight requests aren't passed on")
+	}))
+	req := httptest.NewRequest(http.MethodOptions, "/chomp.v1beta1.ChompService/GetFood", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "Connect-Protocol-Version")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	require.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "7200", rec.Header().Get("Access-Control-Max-Age"))
+}
+
+func TestConfigAllowedOrigins(t *testing.T) {
+	tests := map[string]struct {
+		env      map[string]string
+		expected []string
+	}{
+		"unset":  {expected: []string{"*"}},
+		"empty":  {env: map[string]string{"ALLOWED_ORIGINS": ""}},
+		"listed": {env: map[string]string{"ALLOWED_ORIGINS": "https://a.example.com,https://b.example.com"}, expected: []string{"https://a.example.com", "https://b.example.com"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var cfg Config
+			require.NoError(t, envconfig.ProcessWith(context.Background(), &cfg, envconfig.MapLookuper(tc.env)))
+			require.Equal(t, tc.expected, cfg.AllowedOrigins)
+		})
+	}
+}
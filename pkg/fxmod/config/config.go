@@ -0,0 +1,218 @@
+// Package config loads modules' configuration from an optional YAML or TOML
+// file, overridden by environment variables, and reloads it on SIGHUP.
+//
+// A file's keys map onto environment variables: nested keys are joined with
+// underscores and upper-cased, and lists are joined with commas. For example,
+//
+//	grpc_connect:
+//	  port: 8080
+//	cors:
+//	  allowed_origins: ["https://*.example.com"]
+//
+// sets GRPC_CONNECT_PORT and CORS_ALLOWED_ORIGINS, unless they're set in the
+// environment.
+package config
+
+import (
+	"context"
+	"fmt"
+	"github.com/sethvargo/go-envconfig"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+var Module = fx.Module("config",
+	fx.Provide(NewSource),
+	fx.Invoke(WatchSignals),
+)
+
+// Options are set on the command line.
+type Options struct {
+	// Path is the config file. If it's empty, configuration only comes from
+	// the environment.
+	Path string
+}
+
+// Source looks up configuration in the environment, and then in the config
+// file.
+type Source struct {
+	path string
+
+	mu     sync.RWMutex
+	values map[string]string
+	// keys are the keys values were read from in the file, e.g.
+	// "grpc_connect.port".
+	keys map[string]string
+
+	// processMu serializes Process, which guards the fields below.
+	processMu sync.Mutex
+	lastKey   string
+	used      map[string]bool
+	processed map[reflect.Type]any
+
+	reloadMu  sync.Mutex
+	reloaders []func() error
+}
+
+var _ envconfig.Lookuper = (*Source)(nil)
+
+// NewSource reads the config file, if there is one.
+func NewSource(opts Options) (*Source, error) {
+	s := &Source{
+		path:      opts.Path,
+		used:      make(map[string]bool),
+		processed: make(map[reflect.Type]any),
+	}
+	if err := s.read(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Source) read() error {
+	if s.path == "" {
+		return nil
+	}
+	values, keys, err := readFile(s.path)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values, s.keys = values, keys
+	return nil
+}
+
+// Lookup implements envconfig.Lookuper for Process. Environment variables
+// take precedence over the config file.
+func (s *Source) Lookup(key string) (string, bool) {
+	s.lastKey = key
+	s.used[key] = true
+	if v, ok := os.LookupEnv(key); ok {
+		return v, true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Process populates cfg, a pointer to a struct with env tags. Errors name
+// the setting that's invalid and where it was set.
+func (s *Source) Process(ctx context.Context, cfg any) error {
+	s.processMu.Lock()
+	defer s.processMu.Unlock()
+
+	s.lastKey = ""
+	if err := envconfig.ProcessWith(ctx, cfg, s); err != nil {
+		if s.lastKey == "" {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+		return fmt.Errorf("invalid configuration for %s: %w", s.describe(s.lastKey), err)
+	}
+	s.processed[reflect.TypeOf(cfg)] = cfg
+	return nil
+}
+
+// describe says where a setting came from.
+func (s *Source) describe(key string) string {
+	if _, ok := os.LookupEnv(key); ok {
+		return fmt.Sprintf("%s (set in the environment)", key)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if k, ok := s.keys[key]; ok {
+		return fmt.Sprintf("%s (%q in %s)", key, k, s.path)
+	}
+	return fmt.Sprintf("%s (default)", key)
+}
+
+// CheckUnknownKeys fails if the config file sets anything no module reads,
+// which is usually a typo. It must be invoked after every module's config is
+// processed.
+func CheckUnknownKeys(s *Source) error {
+	s.processMu.Lock()
+	defer s.processMu.Unlock()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var unknown []string
+	for key, k := range s.keys {
+		if !s.used[key] {
+			unknown = append(unknown, fmt.Sprintf("%q", k))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown settings in %s: %s", s.path, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// OnReload registers fn to be called when the config file is reloaded. fn
+// should process its config again and apply the settings it can change at
+// runtime. Settings it can't change, e.g. the port, take effect on restart.
+func (s *Source) OnReload(fn func() error) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	s.reloaders = append(s.reloaders, fn)
+}
+
+// Reload reads the config file again and notifies modules. If the file is
+// invalid, the current configuration is kept.
+func (s *Source) Reload() error {
+	if err := s.read(); err != nil {
+		return err
+	}
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	failed := 0
+	for _, fn := range s.reloaders {
+		if err := fn(); err != nil {
+			logrus.WithError(err).Error("Failed to reload settings")
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d modules failed to reload their settings", failed, len(s.reloaders))
+	}
+	return nil
+}
+
+// WatchSignals reloads the config file on SIGHUP.
+func WatchSignals(lc fx.Lifecycle, s *Source) {
+	signals := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			signal.Notify(signals, syscall.SIGHUP)
+			go func() {
+				defer close(done)
+				for range signals {
+					log := logrus.WithField("path", s.path)
+					log.Info("Reloading configuration...")
+					if err := s.Reload(); err != nil {
+						log.WithError(err).Error("Failed to reload configuration")
+						continue
+					}
+					log.Info("Reloaded configuration")
+				}
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			signal.Stop(signals)
+			close(signals)
+			<-done
+			return nil
+		},
+	})
+}
@@ -0,0 +1,322 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type testNestedConfig struct {
+	Port    int           `env:"PORT,default=8080"`
+	Timeout time.Duration `env:"TIMEOUT,default=5s"`
+}
+
+type testConfig struct {
+	Nested   *testNestedConfig `env:",prefix=GRPC_CONNECT_"`
+	LogLevel string            `env:"LOG_LEVEL,default=info"`
+	APIKey   string            `env:"API_KEY" secret:"true"`
+	Token    string            `env:"TOKEN" secret:"true"`
+	Origins  []string          `env:"ALLOWED_ORIGINS"`
+}
+
+// writeFile writes a config file to a temporary directory.
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestReadFile(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		contents string
+		values   map[string]string
+		keys     map[string]string
+		err      string
+	}{
+		"yaml": {
+			name: "config.yaml",
+			contents: `
+log_level: debug
+grpc-connect:
+  port: 9090
+cors:
+  allowed_origins: ["https://a.example.com", "https://b.example.com"]
+empty:
+`,
+			values: map[string]string{
+				"LOG_LEVEL":            "debug",
+				"GRPC_CONNECT_PORT":    "9090",
+				"CORS_ALLOWED_ORIGINS": "https://a.example.com,https://b.example.com",
+				"EMPTY":                "",
+			},
+			keys: map[string]string{
+				"LOG_LEVEL":            "log_level",
+				"GRPC_CONNECT_PORT":    "grpc-connect.port",
+				"CORS_ALLOWED_ORIGINS": "cors.allowed_origins",
+				"EMPTY":                "empty",
+			},
+		},
+		"toml": {
+			name: "config.toml",
+			contents: `
+log_level = "debug"
+
+[grpc_connect]
+port = 9090
+`,
+			values: map[string]string{
+				"LOG_LEVEL":         "debug",
+				"GRPC_CONNECT_PORT": "9090",
+			},
+			keys: map[string]string{
+				"LOG_LEVEL":         "log_level",
+				"GRPC_CONNECT_PORT": "grpc_connect.port",
+			},
+		},
+		"duplicate keys": {
+			name: "config.yml",
+			contents: `
+grpc_connect:
+  port: 1
+grpc_connect_port: 2
+`,
+			err: `"grpc_connect.port" and "grpc_connect_port" both set GRPC_CONNECT_PORT`,
+		},
+		"nested list": {
+			name: "config.yaml",
+			contents: `
+cors:
+  allowed_origins:
+    - origin: https://example.com
+`,
+			err: `"cors.allowed_origins" must be a list of values`,
+		},
+		"invalid yaml": {
+			name:     "config.yaml",
+			contents: "port: [",
+			err:      "failed to parse config file",
+		},
+		"unsupported extension": {
+			name:     "config.json",
+			contents: "{}",
+			err:      "must be .yaml, .yml or .toml",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			values, keys, err := readFile(writeFile(t, tt.name, tt.contents))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.values, values)
+			require.Equal(t, tt.keys, keys)
+		})
+	}
+}
+
+func TestReadFileMissing(t *testing.T) {
+	_, err := NewSource(Options{Path: filepath.Join(t.TempDir(), "config.yaml")})
+	require.ErrorContains(t, err, "failed to read config file")
+}
+
+func TestProcess(t *testing.T) {
+	tests := map[string]struct {
+		contents string
+		env      map[string]string
+		want     *testConfig
+		err      string
+	}{
+		"defaults": {
+			want: &testConfig{
+				Nested:   &testNestedConfig{Port: 8080, Timeout: 5 * time.Second},
+				LogLevel: "info",
+			},
+		},
+		"file": {
+			contents: `
+log_level: debug
+allowed_origins: [a, b]
+grpc_connect:
+  port: 9090
+  timeout: 1m
+`,
+			want: &testConfig{
+				Nested:   &testNestedConfig{Port: 9090, Timeout: time.Minute},
+				LogLevel: "debug",
+				Origins:  []string{"a", "b"},
+			},
+		},
+		"environment overrides file": {
+			contents: `
+log_level: debug
+grpc_connect:
+  port: 9090
+`,
+			env: map[string]string{"GRPC_CONNECT_PORT": "7070"},
+			want: &testConfig{
+				Nested:   &testNestedConfig{Port: 7070, Timeout: 5 * time.Second},
+				LogLevel: "debug",
+			},
+		},
+		"invalid value in file": {
+			contents: `
+grpc_connect:
+  port: eighty
+`,
+			err: `invalid configuration for GRPC_CONNECT_PORT ("grpc_connect.port" in `,
+		},
+		"invalid value in environment": {
+			env: map[string]string{"GRPC_CONNECT_TIMEOUT": "soon"},
+			err: "invalid configuration for GRPC_CONNECT_TIMEOUT (set in the environment)",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			var opts Options
+			if tt.contents != "" {
+				opts.Path = writeFile(t, "config.yaml", tt.contents)
+			}
+			s, err := NewSource(opts)
+			require.NoError(t, err)
+
+			cfg := &testConfig{}
+			err = s.Process(context.Background(), cfg)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, cfg)
+		})
+	}
+}
+
+func TestCheckUnknownKeys(t *testing.T) {
+	tests := map[string]struct {
+		contents string
+		err      string
+	}{
+		"known keys": {
+			contents: "log_level: debug\ngrpc_connect:\n  port: 9090\n",
+		},
+		"unknown keys": {
+			contents: "log_levl: debug\ngrpc_connect:\n  prot: 9090\n",
+			err:      `"grpc_connect.prot", "log_levl"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, err := NewSource(Options{Path: writeFile(t, "config.yaml", tt.contents)})
+			require.NoError(t, err)
+			require.NoError(t, s.Process(context.Background(), &testConfig{}))
+
+			err = CheckUnknownKeys(s)
+			if tt.err != "" {
+				require.ErrorContains(t, err, "unknown settings in")
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPrint(t *testing.T) {
+	t.Setenv("API_KEY", "secret")
+	s, err := NewSource(Options{})
+	require.NoError(t, err)
+	require.NoError(t, s.Process(context.Background(), &testConfig{}))
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Print(&buf))
+	require.NotContains(t, buf.String(), "secret")
+
+	var doc map[string]any
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &doc))
+	require.Equal(t, map[string]any{
+		"grpc_connect": map[string]any{
+			"port":    8080,
+			"timeout": "5s",
+		},
+		"log_level":       "info",
+		"api_key":         redacted,
+		"token":           "",
+		"allowed_origins": []any{},
+	}, doc)
+}
+
+func TestReload(t *testing.T) {
+	path := writeFile(t, "config.yaml", "log_level: debug\n")
+	s, err := NewSource(Options{Path: path})
+	require.NoError(t, err)
+
+	var cfg *testConfig
+	load := func() error {
+		c := &testConfig{}
+		if err := s.Process(context.Background(), c); err != nil {
+			return err
+		}
+		cfg = c
+		return nil
+	}
+	require.NoError(t, load())
+	s.OnReload(load)
+
+	require.NoError(t, os.WriteFile(path, []byte("log_level: warn\n"), 0o600))
+	require.NoError(t, s.Reload())
+	require.Equal(t, "warn", cfg.LogLevel)
+
+	// An invalid file keeps the current configuration.
+	require.NoError(t, os.WriteFile(path, []byte("log_level: ["), 0o600))
+	require.ErrorContains(t, s.Reload(), "failed to parse config file")
+	require.NoError(t, load())
+	require.Equal(t, "warn", cfg.LogLevel)
+
+	// Every module is reloaded, even if one fails.
+	require.NoError(t, os.WriteFile(path, []byte("log_level: error\n"), 0o600))
+	s.OnReload(func() error { return errors.New("failed") })
+	require.EqualError(t, s.Reload(), "1 of 2 modules failed to reload their settings")
+	require.Equal(t, "error", cfg.LogLevel)
+}
+
+func TestWatchSignals(t *testing.T) {
+	path := writeFile(t, "config.yaml", "log_level: debug\n")
+	s, err := NewSource(Options{Path: path})
+	require.NoError(t, err)
+
+	reloaded := make(chan struct{}, 1)
+	s.OnReload(func() error {
+		reloaded <- struct{}{}
+		return nil
+	})
+
+	lc := fxtest.NewLifecycle(t)
+	WatchSignals(lc, s)
+	lc.RequireStart()
+	defer lc.RequireStop()
+
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+	select {
+	case <-reloaded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("configuration wasn't reloaded on SIGHUP")
+	}
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// readFile reads a YAML or TOML config file, depending on its extension. It
+// returns the file's values by environment variable, and the keys they were
+// set with.
+func readFile(path string) (values, keys map[string]string, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var doc map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &doc)
+	case ".toml":
+		err = toml.Unmarshal(b, &doc)
+	default:
+		return nil, nil, fmt.Errorf("config file %s must be .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values = make(map[string]string)
+	keys = make(map[string]string)
+	if err := flatten("", "", doc, values, keys); err != nil {
+		return nil, nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return values, keys, nil
+}
+
+// flatten maps the document's values onto environment variables.
+func flatten(envPrefix, keyPrefix string, doc map[string]any, values, keys map[string]string) error {
+	names := make([]string, 0, len(doc))
+	for name := range doc {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		env := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		key := name
+		if envPrefix != "" {
+			env = envPrefix + "_" + env
+			key = keyPrefix + "." + name
+		}
+
+		var value string
+		switch v := doc[name].(type) {
+		case map[string]any:
+			if err := flatten(env, key, v, values, keys); err != nil {
+				return err
+			}
+			continue
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				switch item.(type) {
+				case map[string]any, []any:
+					return fmt.Errorf("%q must be a list of values", key)
+				}
+				items = append(items, fmt.Sprint(item))
+			}
+			value = strings.Join(items, ",")
+		case nil:
+		default:
+			value = fmt.Sprint(v)
+		}
+
+		if k, ok := keys[env]; ok {
+			return fmt.Errorf("%q and %q both set %s", k, key, env)
+		}
+		values[env] = value
+		keys[env] = key
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// redacted replaces secrets in printed configuration.
+const redacted = "REDACTED"
+
+// Print writes the effective configuration of every module as YAML, which
+// can be used as a config file. Fields tagged secret:"true" are redacted.
+func (s *Source) Print(w io.Writer) error {
+	s.processMu.Lock()
+	doc := make(map[string]any)
+	for _, cfg := range s.processed {
+		addFields(doc, reflect.ValueOf(cfg))
+	}
+	s.processMu.Unlock()
+
+	b, err := yaml.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// addFields adds a config struct's fields to doc, nesting fields with a
+// prefix.
+func addFields(doc map[string]any, v reflect.Value) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if prefix, ok := prefixOption(opts); ok {
+			key := strings.ToLower(strings.TrimSuffix(prefix, "_"))
+			nested, ok := doc[key].(map[string]any)
+			if !ok {
+				nested = make(map[string]any)
+				doc[key] = nested
+			}
+			addFields(nested, v.Field(i))
+			continue
+		}
+
+		fv := v.Field(i)
+		key := strings.ToLower(name)
+		switch {
+		case f.Tag.Get("secret") == "true":
+			if !fv.IsZero() {
+				doc[key] = redacted
+			} else {
+				doc[key] = ""
+			}
+		case fv.Type() == reflect.TypeOf(time.Duration(0)):
+			doc[key] = time.Duration(fv.Int()).String()
+		default:
+			doc[key] = fv.Interface()
+		}
+	}
+}
+
+func prefixOption(opts string) (string, bool) {
+	for _, o := range strings.Split(opts, ",") {
+		if strings.HasPrefix(o, "prefix=") {
+			return strings.TrimPrefix(o, "prefix="), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,174 @@
+package connect
+
+import (
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequestIDHeader carries the ID of a request. It's set on responses by the
+// logging interceptor, and included in access logs.
+const RequestIDHeader = "X-Request-Id"
+
+// Protocols reported in access logs.
+const (
+	protocolGRPC    = "grpc"
+	protocolGRPCWeb = "grpc-web"
+	protocolConnect = "connect"
+	protocolHTTP    = "http"
+)
+
+// AccessLog returns middleware that logs each request handled by next. A
+// sampleRate fraction of requests is logged, but requests that failed with a
+// server error are always logged.
+func AccessLog(next http.Handler, sampleRate float64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		body := &countingReader{ReadCloser: r.Body}
+		if r.Body != nil {
+			r.Body = body
+		}
+		rw := &accessLogWriter{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rw, r)
+
+		protocol := protocolOf(r)
+		code := rpcCode(rw.Header(), rw.status, protocol)
+		failed := rw.status >= http.StatusInternalServerError || serverErrorCodes[code]
+		if !failed && (sampleRate <= 0 || (sampleRate < 1 && rand.Float64() >= sampleRate)) {
+			return
+		}
+
+		fields := logrus.Fields{
+			"method":      r.Method,
+			"procedure":   r.URL.Path,
+			"protocol":    protocol,
+			"status":      rw.status,
+			"bytes_in":    body.n,
+			"bytes_out":   rw.n,
+			"duration_ms": float64(time.Since(start).Microseconds()) / 1000,
+			"client":      r.RemoteAddr,
+			"user_agent":  r.UserAgent(),
+		}
+		if code != "" {
+			fields["code"] = code
+		}
+		if p, ok := PrincipalFromContext(r.Context()); ok {
+			fields["principal"] = p.Name
+		}
+		if id := rw.Header().Get(RequestIDHeader); id != "" {
+			fields["request_id"] = id
+		}
+		log := logrus.WithFields(fields)
+		if failed {
+			log.Warn("Request failed")
+		} else {
+			log.Info("Request handled")
+		}
+	})
+}
+
+// protocolOf returns the RPC protocol of a request, based on its content
+// type.
+func protocolOf(r *http.Request) string {
+	ct := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(ct, "application/grpc-web"):
+		return protocolGRPCWeb
+	case strings.HasPrefix(ct, "application/grpc"):
+		return protocolGRPC
+	case strings.HasPrefix(ct, "application/connect+"),
+		r.Header.Get("Connect-Protocol-Version") != "",
+		r.Method == http.MethodPost && (strings.HasPrefix(ct, "application/json") || strings.HasPrefix(ct, "application/proto")):
+		return protocolConnect
+	default:
+		return protocolHTTP
+	}
+}
+
+// rpcCode returns the Connect code of an RPC, from its gRPC status or, for
+// the Connect protocol, from the HTTP status of a unary response. It's empty
+// if the code isn't known, e.g. when gRPC-Web trailers are in the body.
+func rpcCode(h http.Header, status int, protocol string) string {
+	switch protocol {
+	case protocolGRPC, protocolGRPCWeb:
+		s := h.Get("Grpc-Status")
+		if s == "" {
+			s = h.Get(http.TrailerPrefix + "Grpc-Status")
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return ""
+		}
+		if n == 0 {
+			return "ok"
+		}
+		return connect.Code(n).String()
+	case protocolConnect:
+		if status == http.StatusOK {
+			return "ok"
+		}
+	}
+	return ""
+}
+
+// serverErrorCodes are the codes of RPCs that failed due to the server, as
+// opposed to the client.
+var serverErrorCodes = map[string]bool{
+	connect.CodeUnknown.String():          true,
+	connect.CodeDeadlineExceeded.String(): true,
+	connect.CodeUnimplemented.String():    true,
+	connect.CodeInternal.String():         true,
+	connect.CodeUnavailable.String():      true,
+	connect.CodeDataLoss.String():         true,
+}
+
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// accessLogWriter records the status and size of a response.
+type accessLogWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	n           int64
+}
+
+func (w *accessLogWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *accessLogWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	n, err := w.ResponseWriter.Write(b)
+	w.n += int64(n)
+	return n, err
+}
+
+// Flush is needed for streaming and for gRPC trailers.
+func (w *accessLogWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *accessLogWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
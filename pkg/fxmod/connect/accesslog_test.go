@@ -0,0 +1,119 @@
+package connect
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAccessLogSampling(t *testing.T) {
+	tests := map[string]struct {
+		sampleRate  float64
+		status      int
+		grpcStatus  string
+		contentType string
+		logged      bool
+		level       logrus.Level
+	}{
+		"sampled out":              {sampleRate: 0, status: http.StatusOK},
+		"sampled in":               {sampleRate: 1, status: http.StatusOK, logged: true, level: logrus.InfoLevel},
+		"client error sampled out": {sampleRate: 0, status: http.StatusNotFound},
+		"server error":             {sampleRate: 0, status: http.StatusInternalServerError, logged: true, level: logrus.WarnLevel},
+		"gRPC server error": {
+			sampleRate:  0,
+			status:      http.StatusOK,
+			grpcStatus:  "13",
+			contentType: "application/grpc",
+			logged:      true,
+			level:       logrus.WarnLevel,
+		},
+		"gRPC client error": {sampleRate: 0, status: http.StatusOK, grpcStatus: "5", contentType: "application/grpc"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			hook := test.NewGlobal()
+			logrus.SetOutput(io.Discard)
+			t.Cleanup(func() {
+				logrus.SetOutput(os.Stderr)
+				logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+			})
+
+			h := AccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.ReadAll(r.Body)
+				w.Header().Set(RequestIDHeader, "abc-123")
+				if tc.grpcStatus != "" {
+					w.Header().Set("Grpc-Status", tc.grpcStatus)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte("hello"))
+			}), tc.sampleRate)
+
+			req := httptest.NewRequest(http.MethodPost, "/chomp.v1beta1.ChompService/GetFood", strings.NewReader("{}"))
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			if !tc.logged {
+				require.Empty(t, hook.AllEntries())
+				return
+			}
+			require.Len(t, hook.AllEntries(), 1)
+			e := hook.LastEntry()
+			require.Equal(t, tc.level, e.Level)
+			require.Equal(t, tc.status, e.Data["status"])
+			require.Equal(t, "abc-123", e.Data["request_id"])
+			require.EqualValues(t, 2, e.Data["bytes_in"])
+			require.EqualValues(t, 5, e.Data["bytes_out"])
+		})
+	}
+}
+
+func TestProtocolOf(t *testing.T) {
+	tests := map[string]struct {
+		method   string
+		header   http.Header
+		expected string
+	}{
+		"gRPC":              {method: http.MethodPost, header: http.Header{"Content-Type": {"application/grpc+proto"}}, expected: protocolGRPC},
+		"gRPC-Web":          {method: http.MethodPost, header: http.Header{"Content-Type": {"application/grpc-web+proto"}}, expected: protocolGRPCWeb},
+		"Connect streaming": {method: http.MethodPost, header: http.Header{"Content-Type": {"application/connect+json"}}, expected: protocolConnect},
+		"Connect unary":     {method: http.MethodPost, header: http.Header{"Content-Type": {"application/json"}}, expected: protocolConnect},
+		"Connect GET":       {method: http.MethodGet, header: http.Header{"Connect-Protocol-Version": {"1"}}, expected: protocolConnect},
+		"plain HTTP":        {method: http.MethodGet, header: http.Header{}, expected: protocolHTTP},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, "/", nil)
+			r.Header = tc.header
+			require.Equal(t, tc.expected, protocolOf(r))
+		})
+	}
+}
+
+func TestRPCCode(t *testing.T) {
+	tests := map[string]struct {
+		header   http.Header
+		status   int
+		protocol string
+		expected string
+	}{
+		"gRPC ok":          {header: http.Header{"Grpc-Status": {"0"}}, status: http.StatusOK, protocol: protocolGRPC, expected: "ok"},
+		"gRPC trailer":     {header: http.Header{"Trailer:Grpc-Status": {"14"}}, status: http.StatusOK, protocol: protocolGRPC, expected: "unavailable"},
+		"gRPC-Web in body": {header: http.Header{}, status: http.StatusOK, protocol: protocolGRPCWeb},
+		"Connect ok":       {header: http.Header{}, status: http.StatusOK, protocol: protocolConnect, expected: "ok"},
+		"Connect error":    {header: http.Header{}, status: http.StatusNotFound, protocol: protocolConnect},
+		"plain HTTP":       {header: http.Header{}, status: http.StatusOK, protocol: protocolHTTP},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, rpcCode(tc.header, tc.status, tc.protocol))
+		})
+	}
+}
@@ -8,24 +8,31 @@ import (
 	grpchealth "github.com/bufbuild/connect-grpchealth-go"
 	grpcreflect "github.com/bufbuild/connect-grpcreflect-go"
 	"github.com/kevinmichaelchen/chomp-proxy/pkg/cors"
-	"github.com/sethvargo/go-envconfig"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/config"
 	"github.com/sirupsen/logrus"
 	"go.uber.org/fx"
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
+	"net"
 	"net/http"
+	"sync/atomic"
 	"time"
 )
 
 func CreateModule(opts *ModuleOptions) fx.Option {
+	providers := make([]any, 0, len(opts.HandlerProviders))
+	for _, p := range opts.HandlerProviders {
+		providers = append(providers, AsHandler(p))
+	}
 	return fx.Module("grpc",
+		fx.Provide(providers...),
 		fx.Provide(
-			opts.HandlerProvider,
 			func() *ModuleOptions {
 				return opts
 			},
 			NewConfig,
+			NewHandlerOptions,
+			NewHealthChecker,
 			NewServer,
+			AsHandlerOption(newCompressionOption),
 		),
 		fx.Invoke(
 			Register,
@@ -33,83 +40,244 @@ func CreateModule(opts *ModuleOptions) fx.Option {
 	)
 }
 
+// HandlerOutput is a Connect service's handler.
 type HandlerOutput struct {
+	// Service is the fully-qualified protobuf service name, which is used for
+	// health checks and reflection. protoc-gen-connect-go generates a
+	// constant for it, e.g. foov1beta1connect.FooServiceName.
+	Service string
 	Path    string
 	Handler http.Handler
 }
 
+// AsHandler annotates a constructor of a HandlerOutput, so it's registered
+// on the server. Modules can provide handlers this way, in addition to those
+// in ModuleOptions.
+func AsHandler(f any) any {
+	return fx.Annotate(f, fx.ResultTags(`group:"connect_handlers"`))
+}
+
 type ModuleOptions struct {
-	HandlerProvider any
-	ServiceName     string
-	Services        []string
+	// HandlerProviders are constructors of the server's HandlerOutputs.
+	HandlerProviders []any
+	// ExposedHeaders are the custom headers the handlers add to responses,
+	// which browsers are allowed to read.
+	ExposedHeaders []string
 }
 
 type Config struct {
 	ConnectConfig *NestedConfig `env:",prefix=GRPC_CONNECT_"`
+	CORSConfig    *cors.Config  `env:",prefix=CORS_"`
 }
 
 type NestedConfig struct {
-	Host string `env:"HOST,default=localhost"`
-	Port int    `env:"PORT,required"`
+	// Network is either "tcp", to listen on Host and Port, or "unix", to
+	// listen on SocketPath.
+	Network    string `env:"NETWORK,default=tcp"`
+	Host       string `env:"HOST,default=localhost"`
+	Port       int    `env:"PORT"`
+	SocketPath string `env:"SOCKET_PATH"`
+	// ListenFD is a listening socket's file descriptor inherited from the
+	// parent process. It takes precedence over Network.
+	ListenFD int `env:"LISTEN_FD"`
+	// SystemdSocketName selects a socket by name when systemd passes several.
+	// Sockets passed by systemd socket activation take precedence over
+	// ListenFD and Network.
+	SystemdSocketName string `env:"SYSTEMD_SOCKET_NAME"`
+
+	// CompressMinBytes is the smallest response that's compressed.
+	CompressMinBytes int `env:"COMPRESS_MIN_BYTES,default=1024"`
+
+	// AccessLogSampleRate is the fraction of requests that are logged.
+	// Requests that fail with a server error are always logged.
+	AccessLogSampleRate float64 `env:"ACCESS_LOG_SAMPLE_RATE,default=1"`
+
+	// TLSCertFile and TLSKeyFile enable TLS. The files are reloaded when
+	// they change.
+	TLSCertFile   string `env:"TLS_CERT_FILE"`
+	TLSKeyFile    string `env:"TLS_KEY_FILE"`
+	TLSMinVersion string `env:"TLS_MIN_VERSION,default=1.2"`
+	// TLSClientCAFile enables mTLS, verifying client certificates against
+	// the CA bundle.
+	TLSClientCAFile string `env:"TLS_CLIENT_CA_FILE"`
+	// TLSClientAuth is either "require" or "verify_if_given".
+	TLSClientAuth string `env:"TLS_CLIENT_AUTH,default=require"`
+
+	// DrainPeriod is how long the server keeps serving after reporting
+	// NOT_SERVING on shutdown, so load balancers stop sending it requests.
+	DrainPeriod time.Duration `env:"DRAIN_PERIOD,default=5s"`
+	// ShutdownTimeout bounds how long in-flight requests have to complete
+	// after draining. Requests still running are then cancelled. Together,
+	// the drain period and shutdown timeout must fit within StopTimeout.
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT,default=8s"`
+
+	// HealthCheckInterval is how often health probes run. Each probe gets
+	// HealthCheckTimeout to complete.
+	HealthCheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL,default=30s"`
+	HealthCheckTimeout  time.Duration `env:"HEALTH_CHECK_TIMEOUT,default=5s"`
 }
 
-func NewConfig() (cfg Config, err error) {
-	err = envconfig.Process(context.Background(), &cfg)
+func NewConfig(src *config.Source) (cfg Config, err error) {
+	err = src.Process(context.Background(), &cfg)
 	return
 }
 
-func NewServer(lc fx.Lifecycle, cfg Config) *http.ServeMux {
+func newCompressionOption(cfg Config) connect.HandlerOption {
+	return connect.WithCompressMinBytes(cfg.ConnectConfig.CompressMinBytes)
+}
+
+func NewServer(
+	lc fx.Lifecycle,
+	cfg Config,
+	opts *ModuleOptions,
+	checker *HealthChecker,
+	src *config.Source,
+) (*http.ServeMux, error) {
+	if err := validateShutdown(cfg.ConnectConfig); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := newTLSConfig(cfg.ConnectConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	mux := http.NewServeMux()
-	addr := fmt.Sprintf("%s:%d", cfg.ConnectConfig.Host, cfg.ConnectConfig.Port)
+	// The CORS policy and access log sampling are reloaded with the config
+	// file.
+	newHandler := func(cfg Config) (http.Handler, error) {
+		corsHandler, err := cors.NewCORS(*cfg.CORSConfig, opts.ExposedHeaders...)
+		if err != nil {
+			return nil, err
+		}
+		return AccessLog(corsHandler.Handler(mux), cfg.ConnectConfig.AccessLogSampleRate), nil
+	}
+	h, err := newHandler(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var handler atomic.Value
+	handler.Store(h)
+	src.OnReload(func() error {
+		cfg, err := NewConfig(src)
+		if err != nil {
+			return err
+		}
+		h, err := newHandler(cfg)
+		if err != nil {
+			return err
+		}
+		handler.Store(h)
+		return nil
+	})
+
+	// Requests' contexts are only cancelled if they outlive the shutdown
+	// timeout, which cancels their upstream calls.
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	inFlight := &inFlightRequests{}
+	conns := &openConns{}
 	srv := &http.Server{
-		Addr: addr,
-		// Use h2c, so we can serve HTTP/2 without TLS.
-		Handler: h2c.NewHandler(
-			cors.NewCORS().Handler(mux),
-			&http2.Server{},
-		),
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
+		TLSConfig:         tlsConfig,
 		ReadHeaderTimeout: time.Second,
 		ReadTimeout:       5 * time.Minute,
 		WriteTimeout:      5 * time.Minute,
 		MaxHeaderBytes:    8 * 1024, // 8KiB
 	}
+	err = serveH2C(srv, inFlight.track(withPrincipal(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.Load().(http.Handler).ServeHTTP(w, r)
+	}))))
+	if err != nil {
+		cancelRequests()
+		return nil, err
+	}
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
-			// In production, we'd want to separate the Listen and Serve phases for
-			// better error-handling.
+			// Bind synchronously, so startup fails if the address is in use.
+			ln, err := listen(cfg.ConnectConfig)
+			if err != nil {
+				return fmt.Errorf("failed to listen for connect-go: %w", err)
+			}
+			ln = conns.track(ln)
 			go func() {
-				err := srv.ListenAndServe()
+				var err error
+				if tlsConfig != nil {
+					// The certificate comes from the TLS config.
+					err = srv.ServeTLS(ln, "", "")
+				} else {
+					err = srv.Serve(ln)
+				}
 				if err != nil && !errors.Is(err, http.ErrServerClosed) {
-					logrus.WithError(err).Error("connect-go ListenAndServe failed")
+					logrus.WithError(err).Error("connect-go Serve failed")
 				}
 			}()
-			logrus.WithField("address", addr).Info("Listening for connect-go")
+			logrus.WithFields(logrus.Fields{
+				"network": ln.Addr().Network(),
+				"address": ln.Addr().String(),
+				"tls":     tlsConfig != nil,
+				"mtls":    tlsConfig != nil && tlsConfig.ClientCAs != nil,
+			}).Info("Listening for connect-go")
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
-			return srv.Shutdown(ctx)
+			defer cancelRequests()
+			return shutdown(ctx, srv, cfg.ConnectConfig, checker, inFlight, conns, cancelRequests)
 		},
 	})
-	return mux
+	return mux, nil
 }
 
-func Register(opts *ModuleOptions, mux *http.ServeMux, h HandlerOutput) {
-	checker := grpchealth.NewStaticChecker(
-		// protoc-gen-connect-go generates package-level constants
-		// for these fully-qualified protobuf service names, so we'd be able
-		// to reference foov1beta1.FooService as opposed to foo.v1beta1.FooService.
-		opts.Services...,
-	)
-	mux.Handle(grpchealth.NewHandler(checker))
-	mux.Handle(h.Path, h.Handler)
+type RegisterParams struct {
+	fx.In
+
+	Mux      *http.ServeMux
+	Handlers []HandlerOutput `group:"connect_handlers"`
+	Checker  *HealthChecker
+	Options  HandlerOptions
+}
+
+// Register mounts the handlers, along with health checks and reflection for
+// their services. Reflection is served with the same handler options as the
+// services.
+func Register(p RegisterParams) error {
+	mux := p.Mux
+	mux.Handle(grpchealth.NewHandler(p.Checker))
+	mux.Handle("/healthz/live", p.Checker.LivenessHandler())
+	mux.Handle("/healthz/ready", p.Checker.ReadinessHandler())
+
+	services, err := serviceNames(p.Handlers)
+	if err != nil {
+		return err
+	}
+	for _, h := range p.Handlers {
+		mux.Handle(h.Path, h.Handler)
+	}
 
-	compress1KB := connect.WithCompressMinBytes(1024)
 	mux.Handle(grpcreflect.NewHandlerV1(
-		grpcreflect.NewStaticReflector(opts.ServiceName),
-		compress1KB,
+		grpcreflect.NewStaticReflector(services...),
+		p.Options...,
 	))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(
-		grpcreflect.NewStaticReflector(opts.ServiceName),
-		compress1KB,
+		grpcreflect.NewStaticReflector(services...),
+		p.Options...,
 	))
+	return nil
+}
+
+// serviceNames returns the handlers' service names, which must be unique.
+func serviceNames(handlers []HandlerOutput) ([]string, error) {
+	seen := make(map[string]bool, len(handlers))
+	services := make([]string, 0, len(handlers))
+	for _, h := range handlers {
+		if h.Service == "" {
+			return nil, fmt.Errorf("handler for %s has no service name", h.Path)
+		}
+		if seen[h.Service] {
+			return nil, fmt.Errorf("service %s is registered more than once", h.Service)
+		}
+		seen[h.Service] = true
+		services = append(services, h.Service)
+	}
+	return services, nil
 }
@@ -0,0 +1,107 @@
+package connect
+
+import (
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	grpcreflect "github.com/bufbuild/connect-grpcreflect-go"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServiceNames(t *testing.T) {
+	tests := map[string]struct {
+		handlers []HandlerOutput
+		expected []string
+		err      string
+	}{
+		"no handlers": {
+			expected: []string{},
+		},
+		"unique services": {
+			handlers: []HandlerOutput{
+				{Service: foodService, Path: "/" + foodService + "/"},
+				{Service: mealService, Path: "/" + mealService + "/"},
+			},
+			expected: []string{foodService, mealService},
+		},
+		"missing service name": {
+			handlers: []HandlerOutput{
+				{Path: "/" + foodService + "/"},
+			},
+			err: "handler for /chomp.v1beta1.ChompService/ has no service name",
+		},
+		"duplicate service": {
+			handlers: []HandlerOutput{
+				{Service: foodService, Path: "/" + foodService + "/"},
+				{Service: foodService, Path: "/v2/" + foodService + "/"},
+			},
+			err: "service chomp.v1beta1.ChompService is registered more than once",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			services, err := serviceNames(tt.handlers)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, services)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	handler := func(service string) HandlerOutput {
+		return HandlerOutput{
+			Service: service,
+			Path:    "/" + service + "/",
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(service))
+			}),
+		}
+	}
+	mux := http.NewServeMux()
+	checker := newHealthChecker([]string{foodService, mealService}, nil, time.Minute, time.Second)
+	interceptor := &recorder{calls: new([]string)}
+	require.NoError(t, Register(RegisterParams{
+		Mux:      mux,
+		Handlers: []HandlerOutput{handler(foodService), handler(mealService)},
+		Checker:  checker,
+		Options:  HandlerOptions{connect.WithInterceptors(interceptor)},
+	}))
+	// Both reflection handlers are created with the handler options.
+	require.Equal(t, 2, interceptor.wrapped)
+
+	for _, service := range []string{foodService, mealService} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/"+service+"/Method", nil))
+		require.Equal(t, service, rec.Body.String())
+	}
+
+	healthPath, _ := grpchealth.NewHandler(checker)
+	reflectionPath, _ := grpcreflect.NewHandlerV1(grpcreflect.NewStaticReflector())
+	reflectionAlphaPath, _ := grpcreflect.NewHandlerV1Alpha(grpcreflect.NewStaticReflector())
+	for _, path := range []string{healthPath, reflectionPath, reflectionAlphaPath, "/healthz/live", "/healthz/ready"} {
+		_, pattern := mux.Handler(httptest.NewRequest(http.MethodPost, path, nil))
+		require.Equal(t, path, pattern)
+	}
+}
+
+func TestRegisterDuplicateService(t *testing.T) {
+	handler := HandlerOutput{
+		Service: foodService,
+		Path:    "/" + foodService + "/",
+		Handler: http.NotFoundHandler(),
+	}
+	err := Register(RegisterParams{
+		Mux:      http.NewServeMux(),
+		Handlers: []HandlerOutput{handler, handler},
+		Checker:  newHealthChecker(nil, nil, time.Minute, time.Second),
+	})
+	require.EqualError(t, err, "service chomp.v1beta1.ChompService is registered more than once")
+}
@@ -0,0 +1,282 @@
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Probe checks a dependency of the server, such as the upstream Chomp API.
+// Provide probes to the "health_probes" value group to have them run
+// periodically.
+type Probe struct {
+	// Name identifies the probe in readiness reports.
+	Name string
+	// Services are the services that depend on the probe. If it's empty, all
+	// services do.
+	Services []string
+	// Check returns an error if the dependency is unhealthy.
+	Check func(ctx context.Context) error
+}
+
+// probeResult is the outcome of a probe's last run.
+type probeResult struct {
+	err       error
+	checkedAt time.Time
+}
+
+// HealthChecker reports the health of the server's services, based on the
+// results of its probes. Unlike grpchealth.StaticChecker, it also reports the
+// server's overall health (the empty service name). Every service is
+// NOT_SERVING until the probes have run once, and once the server starts
+// shutting down.
+type HealthChecker struct {
+	probes   []Probe
+	interval time.Duration
+	timeout  time.Duration
+
+	mu           sync.RWMutex
+	statuses     map[string]grpchealth.Status
+	results      map[string]probeResult
+	shuttingDown bool
+}
+
+var _ grpchealth.Checker = (*HealthChecker)(nil)
+
+type HealthCheckerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Config    Config
+	Handlers  []HandlerOutput `group:"connect_handlers"`
+	Probes    []Probe         `group:"health_probes"`
+}
+
+// NewHealthChecker returns a checker that runs the probes periodically while
+// the app is running.
+func NewHealthChecker(p HealthCheckerParams) *HealthChecker {
+	services := make([]string, 0, len(p.Handlers))
+	for _, h := range p.Handlers {
+		services = append(services, h.Service)
+	}
+	c := newHealthChecker(services, p.Probes, p.Config.ConnectConfig.HealthCheckInterval,
+		p.Config.ConnectConfig.HealthCheckTimeout)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				c.run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			<-done
+			return nil
+		},
+	})
+	return c
+}
+
+func newHealthChecker(services []string, probes []Probe, interval, timeout time.Duration) *HealthChecker {
+	statuses := make(map[string]grpchealth.Status, len(services))
+	for _, s := range services {
+		statuses[s] = grpchealth.StatusServing
+	}
+	return &HealthChecker{
+		probes:   probes,
+		interval: interval,
+		timeout:  timeout,
+		statuses: statuses,
+		results:  make(map[string]probeResult),
+	}
+}
+
+// run runs the probes every interval until ctx is done.
+func (c *HealthChecker) run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probe runs every probe concurrently and records their results.
+func (c *HealthChecker) probe(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, p := range c.probes {
+		wg.Add(1)
+		go func(p Probe) {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			err := p.Check(probeCtx)
+
+			c.mu.Lock()
+			prev, ran := c.results[p.Name]
+			c.results[p.Name] = probeResult{err: err, checkedAt: time.Now()}
+			c.mu.Unlock()
+
+			log := logrus.WithField("probe", p.Name)
+			switch {
+			case err != nil && (!ran || prev.err == nil):
+				log.WithError(err).Warn("Health probe failed")
+			case err == nil && ran && prev.err != nil:
+				log.Info("Health probe recovered")
+			}
+		}(p)
+	}
+	wg.Wait()
+}
+
+// SetStatus sets the health status of a service, registering it if
+// necessary. Failing probes still make the service NOT_SERVING.
+func (c *HealthChecker) SetStatus(service string, status grpchealth.Status) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statuses[service] = status
+}
+
+// ShuttingDown reports every service as NOT_SERVING from now on.
+func (c *HealthChecker) ShuttingDown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shuttingDown = true
+}
+
+// Check implements grpchealth.Checker.
+func (c *HealthChecker) Check(_ context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if _, registered := c.statuses[req.Service]; !registered && req.Service != "" {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("unknown service %s", req.Service),
+		)
+	}
+	return &grpchealth.CheckResponse{Status: c.status(req.Service)}, nil
+}
+
+// status returns the status of a registered service, or the overall status
+// for the empty service name. c.mu must be held.
+func (c *HealthChecker) status(service string) grpchealth.Status {
+	if c.shuttingDown {
+		return grpchealth.StatusNotServing
+	}
+	status := grpchealth.StatusServing
+	if service != "" {
+		status = c.statuses[service]
+	}
+	for _, p := range c.probes {
+		if service != "" && len(p.Services) > 0 && !contains(p.Services, service) {
+			continue
+		}
+		if r, ran := c.results[p.Name]; !ran || r.err != nil {
+			return grpchealth.StatusNotServing
+		}
+	}
+	return status
+}
+
+func contains(values []string, v string) bool {
+	for _, w := range values {
+		if w == v {
+			return true
+		}
+	}
+	return false
+}
+
+func statusName(s grpchealth.Status) string {
+	switch s {
+	case grpchealth.StatusServing:
+		return "SERVING"
+	case grpchealth.StatusNotServing:
+		return "NOT_SERVING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// readinessReport is the body of the readiness endpoint.
+type readinessReport struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services"`
+	Probes   []probeReport     `json:"probes"`
+}
+
+type probeReport struct {
+	Name      string     `json:"name"`
+	Healthy   bool       `json:"healthy"`
+	Error     string     `json:"error,omitempty"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
+
+// LivenessHandler reports that the process is up and serving HTTP. It doesn't
+// depend on probes, so an unreachable upstream doesn't get the server
+// restarted.
+func (c *HealthChecker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}` + "\n"))
+	})
+}
+
+// ReadinessHandler reports whether the server should receive traffic, with
+// the status of each service and probe. It responds with 503 Service
+// Unavailable if the server isn't serving.
+func (c *HealthChecker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mu.RLock()
+		overall := c.status("")
+		report := readinessReport{
+			Status:   statusName(overall),
+			Services: make(map[string]string, len(c.statuses)),
+			Probes:   []probeReport{},
+		}
+		for s := range c.statuses {
+			report.Services[s] = statusName(c.status(s))
+		}
+		for _, p := range c.probes {
+			pr := probeReport{Name: p.Name}
+			if r, ran := c.results[p.Name]; ran {
+				checkedAt := r.checkedAt
+				pr.CheckedAt = &checkedAt
+				pr.Healthy = r.err == nil
+				if r.err != nil {
+					pr.Error = r.err.Error()
+				}
+			} else {
+				pr.Error = "not checked yet"
+			}
+			report.Probes = append(report.Probes, pr)
+		}
+		c.mu.RUnlock()
+		sort.Slice(report.Probes, func(i, j int) bool {
+			return report.Probes[i].Name < report.Probes[j].Name
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		if overall != grpchealth.StatusServing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
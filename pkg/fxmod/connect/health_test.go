@@ -0,0 +1,187 @@
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	foodService = "chomp.v1beta1.ChompService"
+	mealService = "chomp.v1beta1.MealService"
+)
+
+func TestHealthCheckerStatus(t *testing.T) {
+	failed := errors.New("connection refused")
+
+	tests := map[string]struct {
+		// results are the probes' errors. If it's nil, they haven't run.
+		results      map[string]error
+		notServing   []string
+		shuttingDown bool
+		expected     map[string]grpchealth.Status
+	}{
+		"probes not run yet": {
+			expected: map[string]grpchealth.Status{
+				"":          grpchealth.StatusNotServing,
+				foodService: grpchealth.StatusNotServing,
+				mealService: grpchealth.StatusNotServing,
+			},
+		},
+		"healthy": {
+			results: map[string]error{"chomp": nil, "cache": nil},
+			expected: map[string]grpchealth.Status{
+				"":          grpchealth.StatusServing,
+				foodService: grpchealth.StatusServing,
+				mealService: grpchealth.StatusServing,
+			},
+		},
+		"probe for one service failing": {
+			results: map[string]error{"chomp": nil, "cache": failed},
+			expected: map[string]grpchealth.Status{
+				"":          grpchealth.StatusNotServing,
+				foodService: grpchealth.StatusServing,
+				mealService: grpchealth.StatusNotServing,
+			},
+		},
+		"probe for every service failing": {
+			results: map[string]error{"chomp": failed, "cache": nil},
+			expected: map[string]grpchealth.Status{
+				"":          grpchealth.StatusNotServing,
+				foodService: grpchealth.StatusNotServing,
+				mealService: grpchealth.StatusNotServing,
+			},
+		},
+		"service set not serving": {
+			results:    map[string]error{"chomp": nil, "cache": nil},
+			notServing: []string{foodService},
+			expected: map[string]grpchealth.Status{
+				"":          grpchealth.StatusServing,
+				foodService: grpchealth.StatusNotServing,
+				mealService: grpchealth.StatusServing,
+			},
+		},
+		"shutting down": {
+			results:      map[string]error{"chomp": nil, "cache": nil},
+			shuttingDown: true,
+			expected: map[string]grpchealth.Status{
+				"":          grpchealth.StatusNotServing,
+				foodService: grpchealth.StatusNotServing,
+				mealService: grpchealth.StatusNotServing,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			check := func(name string) func(context.Context) error {
+				return func(context.Context) error { return tc.results[name] }
+			}
+			c := newHealthChecker([]string{foodService, mealService}, []Probe{
+				{Name: "chomp", Check: check("chomp")},
+				{Name: "cache", Services: []string{mealService}, Check: check("cache")},
+			}, time.Minute, time.Second)
+			if tc.results != nil {
+				c.probe(context.Background())
+			}
+			for _, s := range tc.notServing {
+				c.SetStatus(s, grpchealth.StatusNotServing)
+			}
+			if tc.shuttingDown {
+				c.ShuttingDown()
+			}
+
+			for service, expected := range tc.expected {
+				res, err := c.Check(context.Background(), &grpchealth.CheckRequest{Service: service})
+				require.NoError(t, err)
+				require.Equal(t, statusName(expected), statusName(res.Status), service)
+			}
+		})
+	}
+}
+
+func TestHealthCheckerUnknownService(t *testing.T) {
+	c := newHealthChecker([]string{foodService}, nil, time.Minute, time.Second)
+	_, err := c.Check(context.Background(), &grpchealth.CheckRequest{Service: "unknown.Service"})
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	c.SetStatus("unknown.Service", grpchealth.StatusServing)
+	res, err := c.Check(context.Background(), &grpchealth.CheckRequest{Service: "unknown.Service"})
+	require.NoError(t, err)
+	require.Equal(t, grpchealth.StatusServing, res.Status)
+}
+
+func TestHealthCheckerProbeTimeout(t *testing.T) {
+	c := newHealthChecker([]string{foodService}, []Probe{{
+		Name: "slow",
+		Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}}, time.Minute, 10*time.Millisecond)
+
+	c.probe(context.Background())
+	require.ErrorIs(t, c.results["slow"].err, context.DeadlineExceeded)
+}
+
+func TestReadinessHandler(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		status   int
+		expected readinessReport
+	}{
+		"ready": {
+			status: http.StatusOK,
+			expected: readinessReport{
+				Status:   "SERVING",
+				Services: map[string]string{foodService: "SERVING"},
+				Probes:   []probeReport{{Name: "chomp", Healthy: true}},
+			},
+		},
+		"not ready": {
+			err:    errors.New("chomp API is unreachable"),
+			status: http.StatusServiceUnavailable,
+			expected: readinessReport{
+				Status:   "NOT_SERVING",
+				Services: map[string]string{foodService: "NOT_SERVING"},
+				Probes:   []probeReport{{Name: "chomp", Error: "chomp API is unreachable"}},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newHealthChecker([]string{foodService}, []Probe{{
+				Name:  "chomp",
+				Check: func(context.Context) error { return tc.err },
+			}}, time.Minute, time.Second)
+			c.probe(context.Background())
+
+			rec := httptest.NewRecorder()
+			c.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz/ready", nil))
+			require.Equal(t, tc.status, rec.Code)
+
+			var report readinessReport
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+			require.NotNil(t, report.Probes[0].CheckedAt)
+			report.Probes[0].CheckedAt = nil
+			require.Equal(t, tc.expected, report)
+		})
+	}
+
+	// Liveness doesn't depend on probes.
+	c := newHealthChecker([]string{foodService}, []Probe{{Name: "chomp"}}, time.Minute, time.Second)
+	rec := httptest.NewRecorder()
+	c.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz/live", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	c.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz/ready", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), "not checked yet")
+}
@@ -0,0 +1,72 @@
+package connect
+
+import (
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+	"sort"
+)
+
+// Interceptor orders. Interceptors with a lower order wrap those with a
+// higher one, so tracing sees everything, and recovery is closest to the
+// handler.
+const (
+	OrderTracing    = 100
+	OrderLogging    = 200
+	OrderMetrics    = 300
+	OrderAuth       = 400
+	OrderValidation = 500
+	OrderRecovery   = 600
+)
+
+// Interceptor is an interceptor contributed to every handler.
+type Interceptor struct {
+	// Name identifies the interceptor in logs, and breaks ties in Order.
+	Name  string
+	Order int
+	connect.Interceptor
+}
+
+// AsInterceptor annotates a constructor of an Interceptor, so it's added to
+// every handler.
+func AsInterceptor(f any) any {
+	return fx.Annotate(f, fx.ResultTags(`group:"connect_interceptors"`))
+}
+
+// AsHandlerOption annotates a constructor of a connect.HandlerOption, so
+// it's applied to every handler.
+func AsHandlerOption(f any) any {
+	return fx.Annotate(f, fx.ResultTags(`group:"connect_handler_options"`))
+}
+
+// HandlerOptions are the options handlers are created with: the contributed
+// handler options, and then the interceptors in order.
+type HandlerOptions []connect.HandlerOption
+
+type HandlerOptionsParams struct {
+	fx.In
+
+	Options      []connect.HandlerOption `group:"connect_handler_options"`
+	Interceptors []Interceptor           `group:"connect_interceptors"`
+}
+
+func NewHandlerOptions(p HandlerOptionsParams) HandlerOptions {
+	interceptors := append([]Interceptor(nil), p.Interceptors...)
+	sort.SliceStable(interceptors, func(i, j int) bool {
+		if interceptors[i].Order != interceptors[j].Order {
+			return interceptors[i].Order < interceptors[j].Order
+		}
+		return interceptors[i].Name < interceptors[j].Name
+	})
+
+	chain := make([]connect.Interceptor, 0, len(interceptors))
+	names := make([]string, 0, len(interceptors))
+	for _, i := range interceptors {
+		chain = append(chain, i.Interceptor)
+		names = append(names, i.Name)
+	}
+	logrus.WithField("interceptors", names).Debug("Built interceptor chain")
+
+	opts := append(HandlerOptions(nil), p.Options...)
+	return append(opts, connect.WithInterceptors(chain...))
+}
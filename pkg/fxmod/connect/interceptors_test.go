@@ -0,0 +1,92 @@
+package connect
+
+import (
+	"context"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// recorder is an interceptor that records the calls it intercepts, and the
+// streaming handlers it wraps.
+type recorder struct {
+	name    string
+	calls   *[]string
+	wrapped int
+}
+
+func (r *recorder) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		*r.calls = append(*r.calls, r.name)
+		return next(ctx, req)
+	}
+}
+
+func (r *recorder) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (r *recorder) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	r.wrapped++
+	return next
+}
+
+func TestNewHandlerOptions(t *testing.T) {
+	tests := map[string]struct {
+		interceptors []Interceptor
+		expected     []string
+	}{
+		"no interceptors": {},
+		"by order": {
+			interceptors: []Interceptor{
+				{Name: "metrics", Order: OrderMetrics},
+				{Name: "recovery", Order: OrderRecovery},
+				{Name: "tracing", Order: OrderTracing},
+				{Name: "logging", Order: OrderLogging},
+			},
+			expected: []string{"tracing", "logging", "metrics", "recovery"},
+		},
+		"ties broken by name": {
+			interceptors: []Interceptor{
+				{Name: "validation", Order: OrderValidation},
+				{Name: "quota", Order: OrderAuth},
+				{Name: "auth", Order: OrderAuth},
+			},
+			expected: []string{"auth", "quota", "validation"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			for i := range tt.interceptors {
+				tt.interceptors[i].Interceptor = &recorder{name: tt.interceptors[i].Name, calls: &calls}
+			}
+			opts := NewHandlerOptions(HandlerOptionsParams{
+				Options:      []connect.HandlerOption{connect.WithCompressMinBytes(1024)},
+				Interceptors: tt.interceptors,
+			})
+			// The contributed options come first, then the interceptors.
+			require.Len(t, opts, 2)
+
+			mux := http.NewServeMux()
+			mux.Handle("/test.v1.TestService/Ping", connect.NewUnaryHandler(
+				"/test.v1.TestService/Ping",
+				func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+					return connect.NewResponse(&emptypb.Empty{}), nil
+				},
+				opts...,
+			))
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			client := connect.NewClient[emptypb.Empty, emptypb.Empty](srv.Client(), srv.URL+"/test.v1.TestService/Ping")
+			_, err := client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, calls)
+		})
+	}
+}
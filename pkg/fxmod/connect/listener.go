@@ -0,0 +1,119 @@
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Networks the server can listen on.
+const (
+	NetworkTCP  = "tcp"
+	NetworkUnix = "unix"
+)
+
+// listenFDsStart is the first file descriptor passed by systemd socket
+// activation.
+const listenFDsStart = 3
+
+// listen returns the server's listener. In order of precedence, it's a
+// socket passed by systemd socket activation, an inherited file descriptor,
+// a Unix socket or a TCP address.
+func listen(cfg *NestedConfig) (net.Listener, error) {
+	fd, name, err := activationFD(cfg.SystemdSocketName)
+	if err != nil {
+		return nil, err
+	}
+	if fd > 0 {
+		return fileListener(fd, name)
+	}
+	if cfg.ListenFD > 0 {
+		return fileListener(cfg.ListenFD, "inherited")
+	}
+
+	switch cfg.Network {
+	case NetworkTCP:
+		if cfg.Port == 0 {
+			return nil, errors.New("a port is required to listen on TCP")
+		}
+		return net.Listen("tcp", net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)))
+	case NetworkUnix:
+		if cfg.SocketPath == "" {
+			return nil, errors.New("a socket path is required to listen on a Unix socket")
+		}
+		if err := removeStaleSocket(cfg.SocketPath); err != nil {
+			return nil, err
+		}
+		return net.Listen("unix", cfg.SocketPath)
+	default:
+		return nil, fmt.Errorf("unknown network %q", cfg.Network)
+	}
+}
+
+// activationFD returns the file descriptor of the socket passed to this
+// process by systemd socket activation, or 0 if there isn't one. If name is
+// set, the socket with that name in LISTEN_FDNAMES is used; otherwise the
+// first socket is.
+func activationFD(name string) (int, string, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return 0, "", nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n < 1 {
+		return 0, "", nil
+	}
+	names := strings.Split(os.Getenv("LISTEN_FDNAMES"), ":")
+	// Don't pass the sockets on to child processes.
+	for _, k := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+		os.Unsetenv(k)
+	}
+
+	if name == "" {
+		return listenFDsStart, names[0], nil
+	}
+	for i := 0; i < n && i < len(names); i++ {
+		if names[i] == name {
+			return listenFDsStart + i, name, nil
+		}
+	}
+	return 0, "", fmt.Errorf("no socket named %q was passed by systemd", name)
+}
+
+func fileListener(fd int, name string) (net.Listener, error) {
+	f := os.NewFile(uintptr(fd), name)
+	if f == nil {
+		return nil, fmt.Errorf("invalid file descriptor %d", fd)
+	}
+	// net.FileListener dups the descriptor, so the file can be closed.
+	defer f.Close()
+	ln, err := net.FileListener(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on file descriptor %d: %w", fd, err)
+	}
+	return ln, nil
+}
+
+// removeStaleSocket removes a socket file left behind by a previous process.
+// Other files are left alone, so they cause an error when listening.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return nil
+	}
+	// Don't remove a socket that a running server is still listening on.
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("socket %s is in use", path)
+	}
+	return os.Remove(path)
+}
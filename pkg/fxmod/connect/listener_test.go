@@ -0,0 +1,147 @@
+package connect
+
+import (
+	"github.com/stretchr/testify/require"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+)
+
+func TestActivationFD(t *testing.T) {
+	pid := strconv.Itoa(os.Getpid())
+
+	tests := map[string]struct {
+		pid, fds, names string
+		name            string
+		fd              int
+		fdName          string
+		wantErr         string
+	}{
+		"not activated":        {},
+		"another process":      {pid: "1", fds: "1", names: "http"},
+		"no sockets":           {pid: pid, fds: "0"},
+		"first socket":         {pid: pid, fds: "2", names: "http:metrics", fd: 3, fdName: "http"},
+		"named socket":         {pid: pid, fds: "2", names: "http:metrics", name: "metrics", fd: 4, fdName: "metrics"},
+		"missing named socket": {pid: pid, fds: "2", names: "http:metrics", name: "grpc", wantErr: `no socket named "grpc"`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LISTEN_PID", tc.pid)
+			t.Setenv("LISTEN_FDS", tc.fds)
+			t.Setenv("LISTEN_FDNAMES", tc.names)
+
+			fd, fdName, err := activationFD(tc.name)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.fd, fd)
+			require.Equal(t, tc.fdName, fdName)
+			if tc.fd > 0 {
+				// The variables aren't passed on to child processes.
+				_, ok := os.LookupEnv("LISTEN_FDS")
+				require.False(t, ok)
+			}
+		})
+	}
+}
+
+func TestRemoveStaleSocket(t *testing.T) {
+	tests := map[string]struct {
+		setup   func(t *testing.T, path string)
+		removed bool
+		wantErr string
+	}{
+		"missing": {
+			setup: func(t *testing.T, path string) {},
+		},
+		"regular file": {
+			setup: func(t *testing.T, path string) {
+				require.NoError(t, os.WriteFile(path, nil, 0o600))
+			},
+		},
+		"stale socket": {
+			setup: func(t *testing.T, path string) {
+				ln, err := net.Listen("unix", path)
+				require.NoError(t, err)
+				ln.(*net.UnixListener).SetUnlinkOnClose(false)
+				require.NoError(t, ln.Close())
+			},
+			removed: true,
+		},
+		"socket in use": {
+			setup: func(t *testing.T, path string) {
+				ln, err := net.Listen("unix", path)
+				require.NoError(t, err)
+				t.Cleanup(func() { ln.Close() })
+			},
+			wantErr: "is in use",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "chomp.sock")
+			tc.setup(t, path)
+			_, statErr := os.Lstat(path)
+			existed := statErr == nil
+
+			err := removeStaleSocket(path)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			_, statErr = os.Lstat(path)
+			require.Equal(t, existed && !tc.removed, statErr == nil)
+		})
+	}
+}
+
+func TestListen(t *testing.T) {
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { tcp.Close() })
+	f, err := tcp.(*net.TCPListener).File()
+	require.NoError(t, err)
+	defer f.Close()
+	// The listener takes ownership of the inherited descriptor.
+	inherited, err := syscall.Dup(int(f.Fd()))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		cfg     NestedConfig
+		network string
+		wantErr string
+	}{
+		"TCP":               {cfg: NestedConfig{Network: NetworkTCP, Host: "127.0.0.1", Port: freePort(t)}, network: "tcp"},
+		"TCP without port":  {cfg: NestedConfig{Network: NetworkTCP}, wantErr: "a port is required"},
+		"Unix":              {cfg: NestedConfig{Network: NetworkUnix, SocketPath: filepath.Join(t.TempDir(), "chomp.sock")}, network: "unix"},
+		"Unix without path": {cfg: NestedConfig{Network: NetworkUnix}, wantErr: "a socket path is required"},
+		"inherited":         {cfg: NestedConfig{Network: NetworkUnix, ListenFD: inherited}, network: "tcp"},
+		"unknown network":   {cfg: NestedConfig{Network: "udp"}, wantErr: `unknown network "udp"`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ln, err := listen(&tc.cfg)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			t.Cleanup(func() { ln.Close() })
+			require.Equal(t, tc.network, ln.Addr().Network())
+		})
+	}
+}
+
+// freePort returns a TCP port that's free to listen on.
+func freePort(t *testing.T) int {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	return ln.Addr().(*net.TCPAddr).Port
+}
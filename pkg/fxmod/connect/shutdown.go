@@ -0,0 +1,215 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// StopTimeout is how long the app has to stop. Pass it to fx.StopTimeout, as
+// fx's default leaves too little time to drain the server.
+const StopTimeout = 30 * time.Second
+
+// stopHeadroom is the part of StopTimeout kept for other modules' stop hooks,
+// such as flushing spans.
+const stopHeadroom = 5 * time.Second
+
+// validateShutdown checks that draining and shutting down the server fit
+// within StopTimeout. Otherwise fx would give up on stopping it before
+// in-flight requests are cancelled.
+func validateShutdown(cfg *NestedConfig) error {
+	if cfg.DrainPeriod < 0 || cfg.ShutdownTimeout <= 0 {
+		return fmt.Errorf("drain period (%s) can't be negative and shutdown timeout (%s) must be positive",
+			cfg.DrainPeriod, cfg.ShutdownTimeout)
+	}
+	if budget := StopTimeout - stopHeadroom; cfg.DrainPeriod+cfg.ShutdownTimeout > budget {
+		return fmt.Errorf("drain period (%s) plus shutdown timeout (%s) must be at most %s",
+			cfg.DrainPeriod, cfg.ShutdownTimeout, budget)
+	}
+	return nil
+}
+
+// shutdown stops the server gracefully. It reports the services as
+// NOT_SERVING, waits for the drain period while still serving, then stops
+// accepting connections and waits up to the shutdown timeout for in-flight
+// requests. Requests still running at the deadline are cancelled.
+func shutdown(
+	ctx context.Context,
+	srv *http.Server,
+	cfg *NestedConfig,
+	checker *HealthChecker,
+	inFlight *inFlightRequests,
+	conns *openConns,
+	cancelRequests context.CancelFunc,
+) error {
+	checker.ShuttingDown()
+
+	logrus.WithField("drain_period", cfg.DrainPeriod.String()).Info("Draining connect-go")
+	select {
+	case <-time.After(cfg.DrainPeriod):
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.ShutdownTimeout)
+	defer cancel()
+	logrus.WithField("in_flight", inFlight.count()).Info("Shutting down connect-go")
+
+	err := srv.Shutdown(shutdownCtx)
+	if err == nil {
+		// HTTP/2 cleartext connections are hijacked from the server, so
+		// Shutdown doesn't wait for their requests. It does tell their
+		// clients to stop opening streams (see serveH2C).
+		err = inFlight.wait(shutdownCtx)
+	}
+	if err != nil {
+		logrus.WithError(err).WithField("in_flight", inFlight.count()).
+			Warn("Shutdown timed out, cancelling in-flight requests")
+		cancelRequests()
+		err := srv.Close()
+		// Close doesn't close hijacked connections either.
+		conns.closeAll()
+		return err
+	}
+	return nil
+}
+
+// serveH2C sets the server to serve h with HTTP/2 without TLS (h2c), as
+// well as HTTP/1 and, with TLS, HTTP/2 negotiated with ALPN. The h2c
+// connections are hijacked from the server, so Shutdown only stops them
+// because the HTTP/2 server is also registered with it: Shutdown then sends
+// them GOAWAY, and they close once their streams complete.
+func serveH2C(srv *http.Server, h http.Handler) error {
+	h2s := &http2.Server{}
+	srv.Handler = h2c.NewHandler(h, h2s)
+	return http2.ConfigureServer(srv, h2s)
+}
+
+// openConns tracks the server's open connections, including those hijacked
+// from it, so they can be closed if shutdown times out.
+type openConns struct {
+	mu    sync.Mutex
+	conns map[*trackedConn]struct{}
+}
+
+// track returns a listener whose connections are tracked.
+func (c *openConns) track(ln net.Listener) net.Listener {
+	return &trackingListener{Listener: ln, conns: c}
+}
+
+func (c *openConns) add(conn *trackedConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conns == nil {
+		c.conns = make(map[*trackedConn]struct{})
+	}
+	c.conns[conn] = struct{}{}
+}
+
+func (c *openConns) remove(conn *trackedConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.conns, conn)
+}
+
+func (c *openConns) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.conns)
+}
+
+// closeAll closes every open connection.
+func (c *openConns) closeAll() {
+	c.mu.Lock()
+	conns := make([]*trackedConn, 0, len(c.conns))
+	for conn := range c.conns {
+		conns = append(conns, conn)
+	}
+	c.mu.Unlock()
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+}
+
+type trackingListener struct {
+	net.Listener
+	conns *openConns
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	tc := &trackedConn{Conn: conn, conns: l.conns}
+	l.conns.add(tc)
+	return tc, nil
+}
+
+type trackedConn struct {
+	net.Conn
+	conns *openConns
+	once  sync.Once
+}
+
+func (c *trackedConn) Close() error {
+	c.once.Do(func() { c.conns.remove(c) })
+	return c.Conn.Close()
+}
+
+// inFlightRequests tracks the requests being handled.
+type inFlightRequests struct {
+	mu   sync.Mutex
+	n    int
+	done chan struct{}
+}
+
+func (r *inFlightRequests) track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		r.n++
+		r.mu.Unlock()
+		defer func() {
+			r.mu.Lock()
+			r.n--
+			if r.n == 0 && r.done != nil {
+				close(r.done)
+				r.done = nil
+			}
+			r.mu.Unlock()
+		}()
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (r *inFlightRequests) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.n
+}
+
+// wait waits until no requests are in flight.
+func (r *inFlightRequests) wait(ctx context.Context) error {
+	r.mu.Lock()
+	if r.n == 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	if r.done == nil {
+		r.done = make(chan struct{})
+	}
+	done := r.done
+	r.mu.Unlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,206 @@
+package connect
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestValidateShutdown(t *testing.T) {
+	tests := map[string]struct {
+		drain, timeout time.Duration
+		wantErr        string
+	}{
+		"defaults":       {drain: 5 * time.Second, timeout: 8 * time.Second},
+		"no drain":       {timeout: 8 * time.Second},
+		"at the limit":   {drain: 10 * time.Second, timeout: 15 * time.Second},
+		"over the limit": {drain: 10 * time.Second, timeout: 16 * time.Second, wantErr: "must be at most 25s"},
+		"negative drain": {drain: -time.Second, timeout: 8 * time.Second, wantErr: "can't be negative"},
+		"no timeout":     {drain: 5 * time.Second, wantErr: "must be positive"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateShutdown(&NestedConfig{DrainPeriod: tc.drain, ShutdownTimeout: tc.timeout})
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	const (
+		drain   = 100 * time.Millisecond
+		timeout = 200 * time.Millisecond
+	)
+	tests := map[string]struct {
+		// handle runs for each request, and returns whether the request was
+		// cancelled.
+		handle    func(ctx context.Context) bool
+		cancelled bool
+		minTime   time.Duration
+	}{
+		"requests complete": {
+			handle: func(ctx context.Context) bool {
+				time.Sleep(drain + timeout/4)
+				return ctx.Err() != nil
+			},
+			minTime: drain,
+		},
+		"requests cancelled at the deadline": {
+			handle: func(ctx context.Context) bool {
+				<-ctx.Done()
+				return true
+			},
+			cancelled: true,
+			minTime:   drain + timeout,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			started := make(chan struct{}, 2)
+			cancelled := make(chan bool, 2)
+			baseCtx, cancelRequests := context.WithCancel(context.Background())
+			defer cancelRequests()
+			inFlight := &inFlightRequests{}
+			srv := &http.Server{
+				Handler: inFlight.track(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == "/fast" {
+						return
+					}
+					started <- struct{}{}
+					cancelled <- tc.handle(r.Context())
+				})),
+				BaseContext: func(net.Listener) context.Context { return baseCtx },
+			}
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			go func() { _ = srv.Serve(ln) }()
+			url := "http://" + ln.Addr().String()
+
+			go func() {
+				res, err := http.Get(url + "/slow")
+				if err == nil {
+					_, _ = io.Copy(io.Discard, res.Body)
+					res.Body.Close()
+				}
+			}()
+			<-started
+
+			checker := newHealthChecker([]string{"chomp.v1beta1.ChompService"}, nil, time.Minute, time.Second)
+			start := time.Now()
+			done := make(chan error, 1)
+			go func() {
+				done <- shutdown(context.Background(), srv, &NestedConfig{DrainPeriod: drain, ShutdownTimeout: timeout},
+					checker, inFlight, &openConns{}, cancelRequests)
+			}()
+
+			// The server keeps serving while it drains, but reports that it's
+			// shutting down.
+			time.Sleep(drain / 4)
+			res, err := http.Get(url + "/fast")
+			require.NoError(t, err)
+			res.Body.Close()
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			health, err := checker.Check(context.Background(), &grpchealth.CheckRequest{})
+			require.NoError(t, err)
+			require.Equal(t, grpchealth.StatusNotServing, health.Status)
+
+			require.NoError(t, <-done)
+			require.GreaterOrEqual(t, time.Since(start), tc.minTime)
+			require.Equal(t, tc.cancelled, <-cancelled)
+			require.Zero(t, inFlight.count())
+		})
+	}
+}
+
+func TestShutdownH2C(t *testing.T) {
+	const timeout = 300 * time.Millisecond
+	started := make(chan struct{}, 1)
+	cancelled := make(chan bool, 1)
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+	inFlight := &inFlightRequests{}
+	conns := &openConns{}
+	srv := &http.Server{BaseContext: func(net.Listener) context.Context { return baseCtx }}
+	require.NoError(t, serveH2C(srv, inFlight.track(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fast" {
+			return
+		}
+		started <- struct{}{}
+		<-r.Context().Done()
+		cancelled <- true
+	}))))
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(conns.track(ln)) }()
+	url := "http://" + ln.Addr().String()
+
+	// Every request is a stream on the same h2c connection.
+	client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}}
+	get := func(path string) error {
+		res, err := client.Get(url + path)
+		if err != nil {
+			return err
+		}
+		_, _ = io.Copy(io.Discard, res.Body)
+		return res.Body.Close()
+	}
+	go func() { _ = get("/slow") }()
+	<-started
+	require.Equal(t, 1, conns.count())
+
+	shuttingDown := make(chan struct{})
+	srv.RegisterOnShutdown(func() { close(shuttingDown) })
+	checker := newHealthChecker([]string{"chomp.v1beta1.ChompService"}, nil, time.Minute, time.Second)
+	done := make(chan error, 1)
+	go func() {
+		done <- shutdown(context.Background(), srv, &NestedConfig{ShutdownTimeout: timeout},
+			checker, inFlight, conns, cancelRequests)
+	}()
+
+	// Once Shutdown starts, the connection doesn't accept new streams, even
+	// while a request on it is still running.
+	<-shuttingDown
+	require.Eventually(t, func() bool { return get("/fast") != nil }, timeout/2, 10*time.Millisecond)
+
+	// The hijacked connection is closed when the shutdown times out.
+	require.NoError(t, <-done)
+	require.True(t, <-cancelled)
+	require.Zero(t, conns.count())
+}
+
+func TestInFlightRequestsWait(t *testing.T) {
+	inFlight := &inFlightRequests{}
+	require.NoError(t, inFlight.wait(context.Background()))
+
+	release := make(chan struct{})
+	h := inFlight.track(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	go h.ServeHTTP(nil, &http.Request{})
+	require.Eventually(t, func() bool { return inFlight.count() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.True(t, errors.Is(inFlight.wait(ctx), context.DeadlineExceeded))
+
+	close(release)
+	require.NoError(t, inFlight.wait(context.Background()))
+	require.Zero(t, inFlight.count())
+}
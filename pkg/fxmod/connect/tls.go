@@ -0,0 +1,198 @@
+package connect
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// certReloadInterval is how often the certificate files are checked for
+// changes.
+const certReloadInterval = 10 * time.Second
+
+// Client certificate policies.
+const (
+	ClientAuthRequire       = "require"
+	ClientAuthVerifyIfGiven = "verify_if_given"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// newTLSConfig returns the server's TLS config, or nil if TLS isn't
+// configured.
+func newTLSConfig(cfg *NestedConfig) (*tls.Config, error) {
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		if cfg.TLSClientCAFile != "" {
+			return nil, errors.New("mTLS requires a TLS certificate and key")
+		}
+		return nil, nil
+	}
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+		return nil, errors.New("TLS requires both a certificate and a key")
+	}
+
+	minVersion, ok := tlsVersions[cfg.TLSMinVersion]
+	if !ok {
+		return nil, fmt.Errorf("unknown minimum TLS version %q", cfg.TLSMinVersion)
+	}
+	reloader, err := newCertReloader(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	out := &tls.Config{
+		MinVersion:     minVersion,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if cfg.TLSClientCAFile != "" {
+		b, err := os.ReadFile(cfg.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates found in client CA bundle %s", cfg.TLSClientCAFile)
+		}
+		out.ClientCAs = pool
+		switch cfg.TLSClientAuth {
+		case ClientAuthRequire:
+			out.ClientAuth = tls.RequireAndVerifyClientCert
+		case ClientAuthVerifyIfGiven:
+			out.ClientAuth = tls.VerifyClientCertIfGiven
+		default:
+			return nil, fmt.Errorf("unknown client auth policy %q", cfg.TLSClientAuth)
+		}
+	}
+	return out, nil
+}
+
+// certReloader serves a certificate loaded from files, reloading it when
+// the files change so certificates can be rotated without a restart.
+type certReloader struct {
+	certFile, keyFile string
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	modTimes  [2]time.Time
+	checkedAt time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTimes); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) stat() ([2]time.Time, error) {
+	var out [2]time.Time
+	for i, name := range []string{r.certFile, r.keyFile} {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return out, fmt.Errorf("failed to stat TLS file: %w", err)
+		}
+		out[i] = fi.ModTime()
+	}
+	return out, nil
+}
+
+func (r *certReloader) load(modTimes [2]time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	r.cert = &cert
+	r.modTimes = modTimes
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate. If the certificate
+// can't be reloaded, the previous one is kept.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < certReloadInterval {
+		return r.cert, nil
+	}
+	r.checkedAt = time.Now()
+
+	modTimes, err := r.stat()
+	if err != nil {
+		logrus.WithError(err).Error("Failed to check TLS certificate for changes")
+		return r.cert, nil
+	}
+	if modTimes == r.modTimes {
+		return r.cert, nil
+	}
+	if err := r.load(modTimes); err != nil {
+		logrus.WithError(err).Error("Failed to reload TLS certificate")
+		return r.cert, nil
+	}
+	logrus.WithField("cert_file", r.certFile).Info("Reloaded TLS certificate")
+	return r.cert, nil
+}
+
+// Principal identifies a client authenticated with a client certificate.
+type Principal struct {
+	// Name is the certificate subject's common name, or if that's empty, its
+	// first URI (e.g. a SPIFFE ID) or DNS subject alternative name.
+	Name string
+	// Subject is the certificate's full subject distinguished name.
+	Subject string
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the principal of the client that made the
+// request, if it presented a verified client certificate. Connect handlers
+// and interceptors can call it with the context they're given, e.g. to
+// authorize the client.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// newPrincipal maps a client certificate to a principal.
+func newPrincipal(cert *x509.Certificate) Principal {
+	p := Principal{
+		Name:    cert.Subject.CommonName,
+		Subject: cert.Subject.String(),
+	}
+	if p.Name == "" && len(cert.URIs) > 0 {
+		p.Name = cert.URIs[0].String()
+	}
+	if p.Name == "" && len(cert.DNSNames) > 0 {
+		p.Name = cert.DNSNames[0]
+	}
+	return p
+}
+
+// withPrincipal returns middleware that adds the principal of clients with
+// verified certificates to the request context.
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			p := newPrincipal(r.TLS.VerifiedChains[0][0])
+			r = r.WithContext(context.WithValue(r.Context(), principalKey{}, p))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
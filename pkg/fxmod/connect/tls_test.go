@@ -0,0 +1,263 @@
+package connect
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a self-signed certificate and its key to dir, returning
+// their paths.
+func writeCert(t *testing.T, dir, commonName string) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, "server")
+	emptyCA := filepath.Join(dir, "empty.pem")
+	require.NoError(t, os.WriteFile(emptyCA, nil, 0o600))
+
+	tests := map[string]struct {
+		cfg        NestedConfig
+		disabled   bool
+		clientAuth tls.ClientAuthType
+		wantErr    string
+	}{
+		"disabled":        {disabled: true},
+		"TLS":             {cfg: NestedConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSMinVersion: "1.2"}},
+		"missing key":     {cfg: NestedConfig{TLSCertFile: certFile, TLSMinVersion: "1.2"}, wantErr: "both a certificate and a key"},
+		"mTLS no cert":    {cfg: NestedConfig{TLSClientCAFile: certFile}, wantErr: "requires a TLS certificate"},
+		"bad version":     {cfg: NestedConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSMinVersion: "1.4"}, wantErr: "unknown minimum TLS version"},
+		"missing file":    {cfg: NestedConfig{TLSCertFile: certFile + ".old", TLSKeyFile: keyFile, TLSMinVersion: "1.2"}, wantErr: "failed to stat"},
+		"empty CA":        {cfg: NestedConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSMinVersion: "1.2", TLSClientCAFile: emptyCA, TLSClientAuth: ClientAuthRequire}, wantErr: "no certificates found"},
+		"bad client auth": {cfg: NestedConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSMinVersion: "1.2", TLSClientCAFile: certFile, TLSClientAuth: "maybe"}, wantErr: "unknown client auth policy"},
+		"mTLS required": {
+			cfg:        NestedConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSMinVersion: "1.2", TLSClientCAFile: certFile, TLSClientAuth: ClientAuthRequire},
+			clientAuth: tls.RequireAndVerifyClientCert,
+		},
+		"mTLS if given": {
+			cfg:        NestedConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSMinVersion: "1.3", TLSClientCAFile: certFile, TLSClientAuth: ClientAuthVerifyIfGiven},
+			clientAuth: tls.VerifyClientCertIfGiven,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := newTLSConfig(&tc.cfg)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.disabled {
+				require.Nil(t, cfg)
+				return
+			}
+			require.Equal(t, tlsVersions[tc.cfg.TLSMinVersion], cfg.MinVersion)
+			require.Equal(t, tc.clientAuth, cfg.ClientAuth)
+			cert, err := cfg.GetCertificate(nil)
+			require.NoError(t, err)
+			require.NotNil(t, cert)
+		})
+	}
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, "first")
+	r, err := newCertReloader(certFile, keyFile)
+	require.NoError(t, err)
+
+	commonName := func() string {
+		cert, err := r.GetCertificate(nil)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return leaf.Subject.CommonName
+	}
+	// touch moves the files' modification times forward, since rotations
+	// can happen within the file system's timestamp resolution.
+	touch := func(d time.Duration) {
+		for _, name := range []string{certFile, keyFile} {
+			at := time.Now().Add(d)
+			require.NoError(t, os.Chtimes(name, at, at))
+		}
+	}
+	require.Equal(t, "first", commonName())
+
+	// Changes aren't noticed until the reload interval has passed.
+	writeCert(t, dir, "second")
+	touch(time.Minute)
+	require.Equal(t, "first", commonName())
+
+	r.checkedAt = time.Time{}
+	require.Equal(t, "second", commonName())
+
+	// A bad certificate is ignored, and the previous one kept.
+	require.NoError(t, os.WriteFile(certFile, []byte("not a certificate"), 0o600))
+	touch(2 * time.Minute)
+	r.checkedAt = time.Time{}
+	require.Equal(t, "second", commonName())
+
+	// So is a missing one.
+	require.NoError(t, os.Remove(keyFile))
+	r.checkedAt = time.Time{}
+	require.Equal(t, "second", commonName())
+}
+
+func TestNewPrincipal(t *testing.T) {
+	spiffe, err := url.Parse("spiffe://example.org/ns/default/sa/web")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		cert     *x509.Certificate
+		expected string
+	}{
+		"common name": {
+			cert:     &x509.Certificate{Subject: pkix.Name{CommonName: "web"}, URIs: []*url.URL{spiffe}},
+			expected: "web",
+		},
+		"URI SAN": {
+			cert:     &x509.Certificate{URIs: []*url.URL{spiffe}, DNSNames: []string{"web.example.org"}},
+			expected: "spiffe://example.org/ns/default/sa/web",
+		},
+		"DNS SAN": {
+			cert:     &x509.Certificate{DNSNames: []string{"web.example.org"}},
+			expected: "web.example.org",
+		},
+		"anonymous": {
+			cert: &x509.Certificate{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := newPrincipal(tc.cert)
+			require.Equal(t, tc.expected, p.Name)
+			require.Equal(t, tc.cert.Subject.String(), p.Subject)
+		})
+	}
+}
+
+func TestWithPrincipal(t *testing.T) {
+	client := &x509.Certificate{Subject: pkix.Name{CommonName: "web", Organization: []string{"Example"}}}
+
+	tests := map[string]struct {
+		state    *tls.ConnectionState
+		expected *Principal
+	}{
+		"plain HTTP":      {},
+		"no client cert":  {state: &tls.ConnectionState{}},
+		"unverified cert": {state: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{client}}},
+		"verified cert": {
+			state:    &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{client}}},
+			expected: &Principal{Name: "web", Subject: "CN=web,O=Example"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got *Principal
+			h := withPrincipal(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if p, ok := PrincipalFromContext(r.Context()); ok {
+					got = &p
+				}
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.TLS = tc.state
+			h.ServeHTTP(httptest.NewRecorder(), req)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPrincipalInHandler(t *testing.T) {
+	serverCert, serverKey := writeCert(t, t.TempDir(), "server")
+	clientCertFile, clientKeyFile := writeCert(t, t.TempDir(), "web")
+	tlsConfig, err := newTLSConfig(&NestedConfig{
+		TLSCertFile:     serverCert,
+		TLSKeyFile:      serverKey,
+		TLSMinVersion:   "1.2",
+		TLSClientCAFile: clientCertFile,
+		TLSClientAuth:   ClientAuthVerifyIfGiven,
+	})
+	require.NoError(t, err)
+	clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	require.NoError(t, err)
+
+	var got *Principal
+	mux := http.NewServeMux()
+	mux.Handle("/test.v1.TestService/Ping", connect.NewUnaryHandler(
+		"/test.v1.TestService/Ping",
+		func(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			if p, ok := PrincipalFromContext(ctx); ok {
+				got = &p
+			}
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+	))
+	srv := httptest.NewUnstartedServer(withPrincipal(mux))
+	srv.TLS = tlsConfig
+	srv.StartTLS()
+	defer srv.Close()
+
+	tests := map[string]struct {
+		certs    []tls.Certificate
+		expected *Principal
+	}{
+		"no client cert": {},
+		"client cert": {
+			certs:    []tls.Certificate{clientCert},
+			expected: &Principal{Name: "web", Subject: "CN=web"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			httpClient := &http.Client{Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					Certificates: tc.certs,
+					// The test certificates have no SANs to verify.
+					InsecureSkipVerify: true,
+				},
+			}}
+			client := connect.NewClient[emptypb.Empty, emptypb.Empty](httpClient, srv.URL+"/test.v1.TestService/Ping")
+			_, err := client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
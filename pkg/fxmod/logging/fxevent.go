@@ -0,0 +1,137 @@
+package logging
+
+import (
+	"github.com/sirupsen/logrus"
+	"go.uber.org/fx/fxevent"
+	"strings"
+)
+
+// FxLogger is an fx event logger that logs to logrus. Dependency graph
+// events are logged at debug level; lifecycle events at info level.
+type FxLogger struct {
+	Logger logrus.FieldLogger
+}
+
+var _ fxevent.Logger = (*FxLogger)(nil)
+
+// NewFxLogger configures the standard logger and returns an fx event logger
+// backed by it. Use it with fx.WithLogger.
+func NewFxLogger(cfg Config) (fxevent.Logger, error) {
+	if err := ConfigureLogger(cfg); err != nil {
+		return nil, err
+	}
+	return &FxLogger{Logger: logrus.WithField("component", "fx")}, nil
+}
+
+// LogEvent logs the given event.
+func (l *FxLogger) LogEvent(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuting:
+		l.Logger.WithFields(logrus.Fields{
+			"callee": e.FunctionName,
+			"caller": e.CallerName,
+		}).Debug("OnStart hook executing")
+	case *fxevent.OnStartExecuted:
+		log := l.Logger.WithFields(logrus.Fields{
+			"callee": e.FunctionName,
+			"caller": e.CallerName,
+		})
+		if e.Err != nil {
+			log.WithError(e.Err).Error("OnStart hook failed")
+		} else {
+			log.WithField("runtime", e.Runtime.String()).Info("OnStart hook executed")
+		}
+	case *fxevent.OnStopExecuting:
+		l.Logger.WithFields(logrus.Fields{
+			"callee": e.FunctionName,
+			"caller": e.CallerName,
+		}).Debug("OnStop hook executing")
+	case *fxevent.OnStopExecuted:
+		log := l.Logger.WithFields(logrus.Fields{
+			"callee": e.FunctionName,
+			"caller": e.CallerName,
+		})
+		if e.Err != nil {
+			log.WithError(e.Err).Error("OnStop hook failed")
+		} else {
+			log.WithField("runtime", e.Runtime.String()).Info("OnStop hook executed")
+		}
+	case *fxevent.Supplied:
+		log := withModule(l.Logger, e.ModuleName).WithField("type", e.TypeName)
+		if e.Err != nil {
+			log.WithError(e.Err).Error("supply failed")
+		} else {
+			log.Debug("supplied")
+		}
+	case *fxevent.Provided:
+		for _, t := range e.OutputTypeNames {
+			withModule(l.Logger, e.ModuleName).WithFields(logrus.Fields{
+				"constructor": e.ConstructorName,
+				"type":        t,
+			}).Debug("provided")
+		}
+		if e.Err != nil {
+			withModule(l.Logger, e.ModuleName).WithError(e.Err).Error("error encountered while applying options")
+		}
+	case *fxevent.Replaced:
+		for _, t := range e.OutputTypeNames {
+			withModule(l.Logger, e.ModuleName).WithField("type", t).Debug("replaced")
+		}
+		if e.Err != nil {
+			withModule(l.Logger, e.ModuleName).WithError(e.Err).Error("error encountered while replacing")
+		}
+	case *fxevent.Decorated:
+		for _, t := range e.OutputTypeNames {
+			withModule(l.Logger, e.ModuleName).WithFields(logrus.Fields{
+				"decorator": e.DecoratorName,
+				"type":      t,
+			}).Debug("decorated")
+		}
+		if e.Err != nil {
+			withModule(l.Logger, e.ModuleName).WithError(e.Err).Error("error encountered while applying options")
+		}
+	case *fxevent.Invoking:
+		// The stack isn't logged as it would make logs hard to read.
+		withModule(l.Logger, e.ModuleName).WithField("function", e.FunctionName).Debug("invoking")
+	case *fxevent.Invoked:
+		if e.Err != nil {
+			withModule(l.Logger, e.ModuleName).WithError(e.Err).WithFields(logrus.Fields{
+				"function": e.FunctionName,
+				"stack":    e.Trace,
+			}).Error("invoke failed")
+		}
+	case *fxevent.Stopping:
+		l.Logger.WithField("signal", strings.ToUpper(e.Signal.String())).Info("received signal")
+	case *fxevent.Stopped:
+		if e.Err != nil {
+			l.Logger.WithError(e.Err).Error("stop failed")
+		} else {
+			l.Logger.Info("stopped")
+		}
+	case *fxevent.RollingBack:
+		l.Logger.WithError(e.StartErr).Error("start failed, rolling back")
+	case *fxevent.RolledBack:
+		if e.Err != nil {
+			l.Logger.WithError(e.Err).Error("rollback failed")
+		}
+	case *fxevent.Started:
+		if e.Err != nil {
+			l.Logger.WithError(e.Err).Error("start failed")
+		} else {
+			l.Logger.Info("started")
+		}
+	case *fxevent.LoggerInitialized:
+		if e.Err != nil {
+			l.Logger.WithError(e.Err).Error("custom logger initialization failed")
+		} else {
+			l.Logger.WithField("function", e.ConstructorName).Debug("initialized custom fxevent.Logger")
+		}
+	}
+}
+
+func withModule(log logrus.FieldLogger, name string) logrus.FieldLogger {
+	if name == "" {
+		return log
+	}
+	return log.WithField("module", name)
+}
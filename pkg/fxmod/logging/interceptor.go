@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// RequestIDHeader carries the request ID. It's propagated from the
+	// request if set, and returned in the response.
+	RequestIDHeader = modConnect.RequestIDHeader
+	// ClientIDHeader identifies the calling client.
+	ClientIDHeader = "X-Client-Id"
+)
+
+// maxRequestIDLength bounds the length of propagated request IDs.
+const maxRequestIDLength = 128
+
+// NewInterceptor contributes the logging interceptor to every handler.
+func NewInterceptor() modConnect.Interceptor {
+	return modConnect.Interceptor{
+		Name:        "logging",
+		Order:       modConnect.OrderLogging,
+		Interceptor: Interceptor(),
+	}
+}
+
+// Interceptor returns a Connect interceptor that puts a request-scoped
+// logger in the context, with the RPC name, request ID and client ID, and
+// logs the outcome of each RPC.
+func Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			requestID := requestID(req.Header())
+			entry := logrus.WithFields(logrus.Fields{
+				"rpc":        req.Spec().Procedure,
+				"request_id": requestID,
+				"client_id":  clientID(ctx, req.Header()),
+			})
+			ctx = NewContext(ctx, entry, start)
+
+			res, err := next(ctx, req)
+
+			log := FromContext(ctx)
+			if err != nil {
+				code := connect.CodeUnknown
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					code = connectErr.Code()
+					connectErr.Meta().Set(RequestIDHeader, requestID)
+				}
+				log.WithError(err).WithField("code", code.String()).Warn("RPC failed")
+				return res, err
+			}
+			res.Header().Set(RequestIDHeader, requestID)
+			log.WithField("code", "ok").Info("RPC succeeded")
+			return res, nil
+		}
+	})
+}
+
+// requestID returns the request's ID from its headers, or a new one.
+func requestID(h http.Header) string {
+	id := strings.TrimSpace(h.Get(RequestIDHeader))
+	if id != "" && len(id) <= maxRequestIDLength {
+		return id
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// clientID identifies the client by its certificate's principal or its
+// client ID header. Otherwise, it's a fingerprint of the client's API key, so
+// requests from the same client can be correlated without logging the key.
+func clientID(ctx context.Context, h http.Header) string {
+	if p, ok := modConnect.PrincipalFromContext(ctx); ok {
+		return p.Name
+	}
+	if id := strings.TrimSpace(h.Get(ClientIDHeader)); id != "" {
+		return id
+	}
+	if key := h.Get("api_key"); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "key:" + hex.EncodeToString(sum[:4])
+	}
+	return ""
+}
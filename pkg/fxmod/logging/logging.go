@@ -1,15 +1,130 @@
 package logging
 
 import (
+	"context"
+	"fmt"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/config"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
 	"github.com/sirupsen/logrus"
 	"go.uber.org/fx"
+	"sync"
+	"time"
+)
+
+// Log formats.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
 )
 
 var Module = fx.Module("logging",
-	fx.Invoke(ConfigureLogger),
+	fx.Provide(
+		NewConfig,
+		modConnect.AsInterceptor(NewInterceptor),
+	),
+	fx.Invoke(
+		ConfigureLogger,
+		ReloadLogger,
+	),
 )
 
-func ConfigureLogger() {
-	// Logs the event in colors if stdout is a tty, otherwise without colors.
-	logrus.SetFormatter(&logrus.TextFormatter{})
+type Config struct {
+	// Level is the minimum level that's logged, e.g. "debug" or "info".
+	Level string `env:"LOG_LEVEL,default=info"`
+
+	// Format is either "text" or "json".
+	Format string `env:"LOG_FORMAT,default=text"`
+}
+
+func NewConfig(src *config.Source) (cfg Config, err error) {
+	err = src.Process(context.Background(), &cfg)
+	return
+}
+
+var addHooks sync.Once
+
+// ConfigureLogger configures the standard logger. It's safe to call more
+// than once.
+func ConfigureLogger(cfg Config) error {
+	level, err := logrus.ParseLevel(cfg.Level)
+	if err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+
+	var formatter logrus.Formatter
+	switch cfg.Format {
+	case FormatText:
+		// Logs the event in colors if stdout is a tty, otherwise without colors.
+		formatter = &logrus.TextFormatter{}
+	case FormatJSON:
+		formatter = &logrus.JSONFormatter{}
+	default:
+		return fmt.Errorf("unknown log format %q", cfg.Format)
+	}
+
+	// Only apply valid settings, so a bad reload leaves the logger as is.
+	logrus.SetLevel(level)
+	logrus.SetFormatter(formatter)
+
+	addHooks.Do(func() {
+		logrus.AddHook(durationHook{})
+	})
+	return nil
+}
+
+// ReloadLogger reconfigures the standard logger when the config file is
+// reloaded.
+func ReloadLogger(src *config.Source) {
+	src.OnReload(func() error {
+		cfg, err := NewConfig(src)
+		if err != nil {
+			return err
+		}
+		return ConfigureLogger(cfg)
+	})
+}
+
+type contextKey struct{}
+
+// requestInfo describes the request being handled.
+type requestInfo struct {
+	entry *logrus.Entry
+	start time.Time
+}
+
+// NewContext returns a context carrying a logger for a request that started
+// at start.
+func NewContext(ctx context.Context, entry *logrus.Entry, start time.Time) context.Context {
+	info := &requestInfo{start: start}
+	ctx = context.WithValue(ctx, contextKey{}, info)
+	info.entry = entry.WithContext(ctx)
+	return ctx
+}
+
+// FromContext returns the request-scoped logger in the context, or the
+// standard logger if there isn't one. Every line it logs includes the time
+// since the request started as duration_ms.
+func FromContext(ctx context.Context) *logrus.Entry {
+	if info, ok := ctx.Value(contextKey{}).(*requestInfo); ok {
+		return info.entry
+	}
+	return logrus.WithContext(ctx)
+}
+
+// durationHook adds the time since the request started to entries logged
+// with a request-scoped logger.
+type durationHook struct{}
+
+func (durationHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (durationHook) Fire(e *logrus.Entry) error {
+	if e.Context == nil {
+		return nil
+	}
+	if info, ok := e.Context.Value(contextKey{}).(*requestInfo); ok {
+		e.Data["duration_ms"] = float64(e.Time.Sub(info.start).Microseconds()) / 1000
+	}
+	return nil
 }
@@ -0,0 +1,245 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxevent"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConfigureLogger(t *testing.T) {
+	tests := map[string]struct {
+		cfg     Config
+		wantErr string
+	}{
+		"text":           {cfg: Config{Level: "debug", Format: FormatText}},
+		"json":           {cfg: Config{Level: "warning", Format: FormatJSON}},
+		"unknown level":  {cfg: Config{Level: "loud", Format: FormatText}, wantErr: "invalid log level"},
+		"unknown format": {cfg: Config{Level: "info", Format: "xml"}, wantErr: "unknown log format"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, ConfigureLogger(Config{Level: "info", Format: FormatText}))
+
+			err := ConfigureLogger(tc.cfg)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				// A bad config leaves the logger as it was.
+				require.Equal(t, logrus.InfoLevel, logrus.GetLevel())
+				require.IsType(t, &logrus.TextFormatter{}, logrus.StandardLogger().Formatter)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.cfg.Level, logrus.GetLevel().String())
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := map[string]struct {
+		header    string
+		expected  string
+		generated bool
+	}{
+		"propagated": {header: "abc-123", expected: "abc-123"},
+		"trimmed":    {header: "  abc-123 ", expected: "abc-123"},
+		"missing":    {generated: true},
+		"blank":      {header: "   ", generated: true},
+		"too long":   {header: strings.Repeat("a", maxRequestIDLength+1), generated: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := http.Header{}
+			h.Set(RequestIDHeader, tc.header)
+			id := requestID(h)
+			if tc.generated {
+				require.Len(t, id, 32)
+				require.NotEqual(t, id, requestID(h))
+				return
+			}
+			require.Equal(t, tc.expected, id)
+		})
+	}
+}
+
+func TestClientID(t *testing.T) {
+	tests := map[string]struct {
+		header   http.Header
+		expected string
+	}{
+		"client ID header":    {header: http.Header{"X-Client-Id": {"mobile-app"}}, expected: "mobile-app"},
+		"header over key":     {header: http.Header{"X-Client-Id": {"mobile-app"}, "Api_key": {"secret"}}, expected: "mobile-app"},
+		"API key fingerprint": {header: http.Header{"Api_key": {"secret"}}, expected: "key:2bb80d53"},
+		"anonymous":           {header: http.Header{}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, clientID(context.Background(), tc.header))
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		err   error
+		code  string
+		level logrus.Level
+	}{
+		"ok":            {code: "ok", level: logrus.InfoLevel},
+		"connect error": {err: connect.NewError(connect.CodeNotFound, errors.New("no foods found")), code: "not_found", level: logrus.WarnLevel},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, ConfigureLogger(Config{Level: "info", Format: FormatText}))
+			hook := test.NewGlobal()
+			logrus.SetOutput(io.Discard)
+			t.Cleanup(func() {
+				logrus.SetOutput(os.Stderr)
+				logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+				addHooks = sync.Once{}
+			})
+
+			next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				FromContext(ctx).Info("handling")
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return connect.NewResponse(&struct{}{}), nil
+			})
+			req := connect.NewRequest(&struct{}{})
+			req.Header().Set(RequestIDHeader, "abc-123")
+			res, err := Interceptor().WrapUnary(next)(context.Background(), req)
+
+			if tc.err != nil {
+				var connectErr *connect.Error
+				require.ErrorAs(t, err, &connectErr)
+				require.Equal(t, "abc-123", connectErr.Meta().Get(RequestIDHeader))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "abc-123", res.Header().Get(RequestIDHeader))
+			}
+
+			entries := hook.AllEntries()
+			require.Len(t, entries, 2)
+			for _, e := range entries {
+				require.Equal(t, "abc-123", e.Data["request_id"])
+			}
+			last := hook.LastEntry()
+			require.Equal(t, tc.level, last.Level)
+			require.Equal(t, tc.code, last.Data["code"])
+			require.Contains(t, last.Data, "duration_ms")
+		})
+	}
+}
+
+func TestFromContextWithoutRequest(t *testing.T) {
+	entry := FromContext(context.Background())
+	require.NotNil(t, entry)
+	require.Empty(t, entry.Data)
+
+	ctx := NewContext(context.Background(), logrus.WithField("rpc", "/x"), time.Now())
+	require.Equal(t, "/x", FromContext(ctx).Data["rpc"])
+}
+
+func TestFxLogger(t *testing.T) {
+	failed := errors.New("failed")
+	tests := map[string]struct {
+		event    fxevent.Event
+		messages []string
+		level    logrus.Level
+		fields   logrus.Fields
+	}{
+		"OnStart executed": {
+			event:    &fxevent.OnStartExecuted{FunctionName: "start", CallerName: "app", Runtime: time.Second},
+			messages: []string{"OnStart hook executed"},
+			level:    logrus.InfoLevel,
+			fields:   logrus.Fields{"callee": "start", "caller": "app", "runtime": "1s"},
+		},
+		"OnStart failed": {
+			event:    &fxevent.OnStartExecuted{FunctionName: "start", CallerName: "app", Err: failed},
+			messages: []string{"OnStart hook failed"},
+			level:    logrus.ErrorLevel,
+			fields:   logrus.Fields{"callee": "start", "caller": "app", logrus.ErrorKey: failed},
+		},
+		"OnStop failed": {
+			event:    &fxevent.OnStopExecuted{FunctionName: "stop", CallerName: "app", Err: failed},
+			messages: []string{"OnStop hook failed"},
+			level:    logrus.ErrorLevel,
+			fields:   logrus.Fields{"callee": "stop", "caller": "app", logrus.ErrorKey: failed},
+		},
+		"provided": {
+			event:    &fxevent.Provided{ConstructorName: "NewService", OutputTypeNames: []string{"*Service"}, ModuleName: "service"},
+			messages: []string{"provided"},
+			level:    logrus.DebugLevel,
+			fields:   logrus.Fields{"constructor": "NewService", "type": "*Service", "module": "service"},
+		},
+		"provide failed": {
+			event:    &fxevent.Provided{ConstructorName: "NewService", OutputTypeNames: []string{"*Service"}, Err: failed},
+			messages: []string{"provided", "error encountered while applying options"},
+			level:    logrus.ErrorLevel,
+			fields:   logrus.Fields{logrus.ErrorKey: failed},
+		},
+		"invoked": {
+			event: &fxevent.Invoked{FunctionName: "Register"},
+		},
+		"invoke failed": {
+			event:    &fxevent.Invoked{FunctionName: "Register", Trace: "main.go:10", Err: failed},
+			messages: []string{"invoke failed"},
+			level:    logrus.ErrorLevel,
+			fields:   logrus.Fields{"function": "Register", "stack": "main.go:10", logrus.ErrorKey: failed},
+		},
+		"started": {
+			event:    &fxevent.Started{},
+			messages: []string{"started"},
+			level:    logrus.InfoLevel,
+			fields:   logrus.Fields{},
+		},
+		"start failed": {
+			event:    &fxevent.Started{Err: failed},
+			messages: []string{"start failed"},
+			level:    logrus.ErrorLevel,
+			fields:   logrus.Fields{logrus.ErrorKey: failed},
+		},
+		"stopped": {
+			event:    &fxevent.Stopped{},
+			messages: []string{"stopped"},
+			level:    logrus.InfoLevel,
+			fields:   logrus.Fields{},
+		},
+		"stop failed": {
+			event:    &fxevent.Stopped{Err: failed},
+			messages: []string{"stop failed"},
+			level:    logrus.ErrorLevel,
+			fields:   logrus.Fields{logrus.ErrorKey: failed},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			logger, hook := test.NewNullLogger()
+			logger.SetLevel(logrus.DebugLevel)
+			(&FxLogger{Logger: logger}).LogEvent(tc.event)
+
+			var messages []string
+			for _, e := range hook.AllEntries() {
+				messages = append(messages, e.Message)
+			}
+			require.Equal(t, tc.messages, messages)
+			if len(tc.messages) == 0 {
+				return
+			}
+			last := hook.LastEntry()
+			require.Equal(t, tc.level, last.Level)
+			require.Equal(t, tc.fields, last.Data)
+		})
+	}
+}
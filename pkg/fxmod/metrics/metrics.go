@@ -0,0 +1,205 @@
+// Package metrics exports Prometheus metrics for Connect RPCs and upstream
+// HTTP calls on the server's mux.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/config"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/fx"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const namespace = "chomp_proxy"
+
+var Module = fx.Module("metrics",
+	fx.Provide(
+		NewConfig,
+		NewMetrics,
+		modConnect.AsInterceptor(NewInterceptor),
+	),
+	fx.Invoke(
+		Register,
+	),
+)
+
+type Config struct {
+	// Path is where metrics are served on the server's mux.
+	Path string `env:"METRICS_PATH,default=/metrics"`
+}
+
+func NewConfig(src *config.Source) (cfg Config, err error) {
+	err = src.Process(context.Background(), &cfg)
+	return
+}
+
+// Metrics holds the proxy's collectors and the registry they're registered
+// with.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcRequests      *prometheus.CounterVec
+	rpcDuration      *prometheus.HistogramVec
+	rpcInFlight      *prometheus.GaugeVec
+	upstreamRequests *prometheus.CounterVec
+	upstreamDuration *prometheus.HistogramVec
+	upstreamInFlight *prometheus.GaugeVec
+	rpcPanics        *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_requests_total",
+			Help:      "Number of RPCs handled, by procedure and Connect code.",
+		}, []string{"procedure", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Time taken to handle RPCs, by procedure and Connect code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"procedure", "code"}),
+		rpcInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "rpc_in_flight_requests",
+			Help:      "Number of RPCs currently being handled, by procedure.",
+		}, []string{"procedure"}),
+		upstreamRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_requests_total",
+			Help:      "Number of HTTP requests made to upstream APIs, by upstream, method and status code.",
+		}, []string{"upstream", "method", "code"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_request_duration_seconds",
+			Help:      "Time taken by HTTP requests to upstream APIs, by upstream, method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"upstream", "method", "code"}),
+		upstreamInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "upstream_in_flight_requests",
+			Help:      "Number of HTTP requests to upstream APIs currently in flight, by upstream.",
+		}, []string{"upstream"}),
+		rpcPanics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_panics_total",
+			Help:      "Number of panics recovered from while handling RPCs, by procedure.",
+		}, []string{"procedure"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcRequests,
+		m.rpcDuration,
+		m.rpcInFlight,
+		m.upstreamRequests,
+		m.upstreamDuration,
+		m.upstreamInFlight,
+		m.rpcPanics,
+	)
+	return m
+}
+
+// Register serves metrics on the mux.
+func Register(cfg Config, mux *http.ServeMux, m *Metrics) {
+	mux.Handle(cfg.Path, m.Handler())
+}
+
+// Handler returns an HTTP handler that serves metrics in the Prometheus
+// exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry: m.registry,
+	})
+}
+
+// Registerer returns the registry, for registering additional collectors.
+func (m *Metrics) Registerer() prometheus.Registerer {
+	return m.registry
+}
+
+// NewInterceptor contributes the RPC metrics interceptor to every handler.
+func NewInterceptor(m *Metrics) modConnect.Interceptor {
+	return modConnect.Interceptor{
+		Name:        "metrics",
+		Order:       modConnect.OrderMetrics,
+		Interceptor: m.Interceptor(),
+	}
+}
+
+// Interceptor returns a Connect interceptor that records request counts,
+// latencies and in-flight requests for each procedure.
+func (m *Metrics) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			inFlight := m.rpcInFlight.WithLabelValues(procedure)
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			start := time.Now()
+			res, err := next(ctx, req)
+			code := codeOf(err)
+			m.rpcRequests.WithLabelValues(procedure, code).Inc()
+			m.rpcDuration.WithLabelValues(procedure, code).Observe(time.Since(start).Seconds())
+			return res, err
+		}
+	})
+}
+
+// codeOf returns the Connect code of an RPC's error, or "ok" if it succeeded.
+func codeOf(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Code().String()
+	}
+	return connect.CodeUnknown.String()
+}
+
+// RoundTripper wraps next to record the count, latency and status codes of
+// requests made to the named upstream. Requests that fail without a response
+// are recorded with the code "error". If next is nil, http.DefaultTransport
+// is used.
+func (m *Metrics) RoundTripper(upstream string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	inFlight := m.upstreamInFlight.WithLabelValues(upstream)
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		start := time.Now()
+		res, err := next.RoundTrip(req)
+		code := "error"
+		if err == nil {
+			code = strconv.Itoa(res.StatusCode)
+		}
+		m.upstreamRequests.WithLabelValues(upstream, req.Method, code).Inc()
+		m.upstreamDuration.WithLabelValues(upstream, req.Method, code).Observe(time.Since(start).Seconds())
+		return res, err
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// ObservePanic records a panic recovered from while handling an RPC.
+func (m *Metrics) ObservePanic(procedure string) {
+	m.rpcPanics.WithLabelValues(procedure).Inc()
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		code string
+	}{
+		"ok":            {code: "ok"},
+		"connect error": {err: connect.NewError(connect.CodeNotFound, errors.New("no foods found")), code: "not_found"},
+		"other error":   {err: errors.New("boom"), code: "unknown"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := NewMetrics()
+			next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				require.Equal(t, 1.0, testutil.ToFloat64(m.rpcInFlight.WithLabelValues("")))
+				return nil, tc.err
+			})
+
+			_, err := m.Interceptor().WrapUnary(next)(context.Background(), connect.NewRequest(&struct{}{}))
+			require.Equal(t, tc.err, err)
+			require.Equal(t, 1.0, testutil.ToFloat64(m.rpcRequests.WithLabelValues("", tc.code)))
+			require.Equal(t, 0.0, testutil.ToFloat64(m.rpcInFlight.WithLabelValues("")))
+			require.Equal(t, 1, testutil.CollectAndCount(m.rpcDuration))
+		})
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	tests := map[string]struct {
+		res  *http.Response
+		err  error
+		code string
+	}{
+		"ok":          {res: &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, code: "200"},
+		"bad gateway": {res: &http.Response{StatusCode: http.StatusBadGateway, Body: http.NoBody}, code: "502"},
+		"no response": {err: errors.New("connection refused"), code: "error"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := NewMetrics()
+			rt := m.RoundTripper("chomp", roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				return tc.res, tc.err
+			}))
+
+			req, err := http.NewRequest(http.MethodGet, "https://chompthis.com/api/v2/food/branded/barcode.php", nil)
+			require.NoError(t, err)
+			_, err = rt.RoundTrip(req)
+			require.Equal(t, tc.err, err)
+			require.Equal(t, 1.0, testutil.ToFloat64(m.upstreamRequests.WithLabelValues("chomp", http.MethodGet, tc.code)))
+			require.Equal(t, 0.0, testutil.ToFloat64(m.upstreamInFlight.WithLabelValues("chomp")))
+		})
+	}
+}
+
+func TestObservePanic(t *testing.T) {
+	m := NewMetrics()
+	m.ObservePanic("/chomp.v1beta1.ChompService/GetFood")
+	m.ObservePanic("/chomp.v1beta1.ChompService/GetFood")
+	require.Equal(t, 2.0, testutil.ToFloat64(m.rpcPanics.WithLabelValues("/chomp.v1beta1.ChompService/GetFood")))
+}
@@ -0,0 +1,150 @@
+// Package recovery recovers from panics in Connect handlers, so a bug in one
+// request fails that request with CodeInternal instead of dropping the
+// connection without a trace.
+package recovery
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/logging"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/metrics"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/fx"
+	"net/http"
+	"runtime/debug"
+)
+
+// redacted replaces credentials in reported headers.
+const redacted = "REDACTED"
+
+// sensitiveHeaders are request headers that are redacted before reporting.
+var sensitiveHeaders = []string{"api_key", "Authorization"}
+
+// ErrorIDHeader carries the ID of a recovered panic, which clients can quote
+// when reporting the error.
+const ErrorIDHeader = "X-Error-Id"
+
+var Module = fx.Module("recovery",
+	fx.Provide(
+		NewRecovery,
+		modConnect.AsInterceptor(NewInterceptor),
+	),
+)
+
+// Event describes a recovered panic.
+type Event struct {
+	// ID is returned to the client in the error and ErrorIDHeader.
+	ID        string
+	Procedure string
+	// Value is the value passed to panic.
+	Value any
+	Stack []byte
+	// Header is the request's headers, with credentials redacted.
+	Header http.Header
+}
+
+// Reporter forwards recovered panics to an error tracker, e.g. Sentry.
+// Report is called before the RPC returns, so it shouldn't block.
+type Reporter interface {
+	Report(ctx context.Context, event Event)
+}
+
+// Recovery recovers from panics in handlers.
+type Recovery struct {
+	metrics  *metrics.Metrics
+	reporter Reporter
+}
+
+type Params struct {
+	fx.In
+
+	Metrics *metrics.Metrics
+	// Reporter is optional. Provide one to forward panics to an error
+	// tracker.
+	Reporter Reporter `optional:"true"`
+}
+
+func NewRecovery(p Params) *Recovery {
+	return &Recovery{
+		metrics:  p.Metrics,
+		reporter: p.Reporter,
+	}
+}
+
+// NewInterceptor contributes the recovery interceptor to every handler.
+func NewInterceptor(r *Recovery) modConnect.Interceptor {
+	return modConnect.Interceptor{
+		Name:        "recovery",
+		Order:       modConnect.OrderRecovery,
+		Interceptor: r.Interceptor(),
+	}
+}
+
+// Interceptor returns a Connect interceptor that recovers from panics in
+// handlers. The panic is logged with its stack, counted and reported, and the
+// RPC fails with CodeInternal and an error ID.
+func (r *Recovery) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (res connect.AnyResponse, err error) {
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+				// net/http uses this panic to abort a response on purpose.
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+				res, err = nil, r.recovered(ctx, req, v, debug.Stack())
+			}()
+			return next(ctx, req)
+		}
+	})
+}
+
+func (r *Recovery) recovered(ctx context.Context, req connect.AnyRequest, v any, stack []byte) error {
+	event := Event{
+		ID:        newErrorID(),
+		Procedure: req.Spec().Procedure,
+		Value:     v,
+		Stack:     stack,
+		Header:    redactHeader(req.Header()),
+	}
+
+	logging.FromContext(ctx).WithFields(logrus.Fields{
+		"error_id": event.ID,
+		"panic":    fmt.Sprint(v),
+		"stack":    string(stack),
+	}).Error("Recovered from panic")
+	r.metrics.ObservePanic(event.Procedure)
+	if r.reporter != nil {
+		r.reporter.Report(ctx, event)
+	}
+
+	err := connect.NewError(connect.CodeInternal, fmt.Errorf("internal error (error ID %s)", event.ID))
+	err.Meta().Set(ErrorIDHeader, event.ID)
+	return err
+}
+
+// newErrorID returns a random ID for a recovered panic.
+func newErrorID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
@@ -0,0 +1,155 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+const procedure = "/chomp.v1beta1.ChompService/GetFood"
+
+type reporterFunc func(ctx context.Context, event Event)
+
+func (f reporterFunc) Report(ctx context.Context, event Event) {
+	f(ctx, event)
+}
+
+func TestInterceptor(t *testing.T) {
+	hook := test.NewGlobal()
+	logrus.SetOutput(io.Discard)
+	t.Cleanup(func() {
+		logrus.SetOutput(os.Stderr)
+		logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+	})
+
+	tests := map[string]struct {
+		handler func()
+		panics  string
+	}{
+		"no panic": {
+			handler: func() {},
+		},
+		"panic": {
+			handler: func() { panic("index out of range") },
+			panics:  "index out of range",
+		},
+		"panic with error": {
+			handler: func() { panic(errors.New("nil map")) },
+			panics:  "nil map",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			hook.Reset()
+			m := metrics.NewMetrics()
+			var events []Event
+			r := NewRecovery(Params{
+				Metrics: m,
+				Reporter: reporterFunc(func(_ context.Context, event Event) {
+					events = append(events, event)
+				}),
+			})
+
+			mux := http.NewServeMux()
+			mux.Handle(procedure, connect.NewUnaryHandler(
+				procedure,
+				func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+					tt.handler()
+					return connect.NewResponse(&emptypb.Empty{}), nil
+				},
+				connect.WithInterceptors(r.Interceptor()),
+			))
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			client := connect.NewClient[emptypb.Empty, emptypb.Empty](srv.Client(), srv.URL+procedure)
+			req := connect.NewRequest(&emptypb.Empty{})
+			req.Header().Set("api_key", "secret")
+			req.Header().Set("Authorization", "Bearer secret")
+			req.Header().Set("X-Client", "test")
+			_, err := client.CallUnary(context.Background(), req)
+
+			if tt.panics == "" {
+				require.NoError(t, err)
+				require.Empty(t, events)
+				require.Empty(t, hook.AllEntries())
+				return
+			}
+
+			require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+			require.Len(t, events, 1)
+			event := events[0]
+			require.Len(t, event.ID, 16)
+			require.Equal(t, procedure, event.Procedure)
+			require.Equal(t, tt.panics, fmt.Sprint(event.Value))
+			require.NotEmpty(t, event.Stack)
+
+			// The client gets the error ID, but not the panic.
+			var connectErr *connect.Error
+			require.True(t, errors.As(err, &connectErr))
+			require.Equal(t, event.ID, connectErr.Meta().Get(ErrorIDHeader))
+			require.Contains(t, connectErr.Message(), event.ID)
+			require.NotContains(t, connectErr.Message(), tt.panics)
+
+			// Credentials are redacted before reporting.
+			require.Equal(t, redacted, event.Header.Get("api_key"))
+			require.Equal(t, redacted, event.Header.Get("Authorization"))
+			require.Equal(t, "test", event.Header.Get("X-Client"))
+
+			entry := hook.LastEntry()
+			require.NotNil(t, entry)
+			require.Equal(t, logrus.ErrorLevel, entry.Level)
+			require.Equal(t, event.ID, entry.Data["error_id"])
+			require.Equal(t, tt.panics, entry.Data["panic"])
+
+			expected := `
+# HELP chomp_proxy_rpc_panics_total Number of panics recovered from while handling RPCs, by procedure.
+# TYPE chomp_proxy_rpc_panics_total counter
+chomp_proxy_rpc_panics_total{procedure="/chomp.v1beta1.ChompService/GetFood"} 1
+`
+			require.NoError(t, testutil.GatherAndCompare(
+				m.Registerer().(prometheus.Gatherer),
+				strings.NewReader(expected),
+				"chomp_proxy_rpc_panics_total",
+			))
+		})
+	}
+}
+
+func TestInterceptorAbortHandler(t *testing.T) {
+	r := NewRecovery(Params{Metrics: metrics.NewMetrics()})
+	next := connect.UnaryFunc(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		panic(http.ErrAbortHandler)
+	})
+	require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		_, _ = r.Interceptor().WrapUnary(next)(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	})
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("api_key", "secret")
+	h.Set("Accept", "application/json")
+
+	redactedHeader := redactHeader(h)
+	require.Equal(t, redacted, redactedHeader.Get("api_key"))
+	require.Empty(t, redactedHeader.Get("Authorization"))
+	require.Equal(t, "application/json", redactedHeader.Get("Accept"))
+	// The request's headers are left alone.
+	require.Equal(t, "secret", h.Get("api_key"))
+}
@@ -0,0 +1,235 @@
+// Package tracing traces Connect RPCs and upstream HTTP calls with
+// OpenTelemetry.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/config"
+	modConnect "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const instrumentationName = "github.com/kevinmichaelchen/chomp-proxy/pkg/fxmod/tracing"
+
+// Exporters.
+const (
+	// ExporterNone doesn't record spans, but still propagates trace context.
+	ExporterNone = "none"
+	// ExporterStdout writes spans to stdout.
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends spans to an OTLP collector over gRPC. The exporter is
+	// configured with the standard OTEL_EXPORTER_OTLP_* environment variables,
+	// e.g. OTEL_EXPORTER_OTLP_ENDPOINT.
+	ExporterOTLP = "otlp"
+)
+
+// redacted replaces secrets in span attributes.
+const redacted = "REDACTED"
+
+// sensitiveParams are query parameters that are redacted from URLs.
+var sensitiveParams = []string{"api_key"}
+
+var Module = fx.Module("tracing",
+	fx.Provide(
+		NewConfig,
+		NewTracing,
+		modConnect.AsInterceptor(NewInterceptor),
+	),
+)
+
+type Config struct {
+	// Exporter is where spans are sent: "none", "stdout" or "otlp".
+	Exporter string `env:"TRACING_EXPORTER,default=none"`
+
+	// ServiceName is the service.name resource attribute.
+	ServiceName string `env:"OTEL_SERVICE_NAME,default=chomp-proxy"`
+
+	// SampleRatio is the fraction of new traces that are sampled. Traces
+	// started by clients follow the client's sampling decision.
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO,default=1"`
+}
+
+func NewConfig(src *config.Source) (cfg Config, err error) {
+	err = src.Process(context.Background(), &cfg)
+	return
+}
+
+// Tracing creates spans using the configured tracer provider and propagates
+// W3C trace context.
+type Tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracing returns a Tracing for the configured exporter. Spans are flushed
+// when the app stops.
+func NewTracing(lc fx.Lifecycle, cfg Config) (*Tracing, error) {
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	otel.SetTextMapPropagator(propagator)
+
+	exporter, err := newExporter(cfg.Exporter)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		tp := trace.NewNoopTracerProvider()
+		return &Tracing{tracer: tp.Tracer(instrumentationName), propagator: propagator}, nil
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return tp.Shutdown(ctx)
+		},
+	})
+	return &Tracing{tracer: tp.Tracer(instrumentationName), propagator: propagator}, nil
+}
+
+func newExporter(name string) (sdktrace.SpanExporter, error) {
+	switch name {
+	case ExporterNone, "":
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		// The gRPC connection is made lazily, so this doesn't block startup.
+		return otlptracegrpc.New(context.Background())
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", name)
+	}
+}
+
+// NewInterceptor contributes the RPC tracing interceptor to every handler.
+func NewInterceptor(t *Tracing) modConnect.Interceptor {
+	return modConnect.Interceptor{
+		Name:        "tracing",
+		Order:       modConnect.OrderTracing,
+		Interceptor: t.Interceptor(),
+	}
+}
+
+// Interceptor returns a Connect interceptor that starts a server span for
+// each RPC, continuing the trace in the request's W3C trace context headers.
+func (t *Tracing) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			ctx = t.propagator.Extract(ctx, propagation.HeaderCarrier(req.Header()))
+
+			// Procedures look like "/chomp.v1beta1.ChompService/GetFood".
+			name := strings.TrimPrefix(req.Spec().Procedure, "/")
+			service, method, _ := strings.Cut(name, "/")
+			ctx, span := t.tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("connect_rpc"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method),
+				),
+			)
+			defer span.End()
+
+			res, err := next(ctx, req)
+			if err != nil {
+				code := connect.CodeUnknown
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					code = connectErr.Code()
+				}
+				span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", code.String()))
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return res, err
+		}
+	})
+}
+
+// RoundTripper wraps next to start a client span for each request made to
+// the named upstream, and to propagate trace context to it. API keys are
+// redacted from the URL recorded on the span. If next is nil,
+// http.DefaultTransport is used.
+func (t *Tracing) RoundTripper(upstream string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		ctx, span := t.tracer.Start(req.Context(), fmt.Sprintf("%s %s", upstream, req.Method),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.PeerService(upstream),
+				semconv.HTTPMethod(req.Method),
+				semconv.HTTPURL(redactURL(req.URL)),
+			),
+		)
+		defer span.End()
+
+		// Don't modify the caller's request.
+		req = req.Clone(ctx)
+		t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		res, err := next.RoundTrip(req)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		span.SetAttributes(semconv.HTTPStatusCode(res.StatusCode))
+		if res.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+		}
+		return res, nil
+	})
+}
+
+// redactURL returns u with the values of sensitive query parameters
+// replaced.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	changed := false
+	for _, p := range sensitiveParams {
+		if q.Has(p) {
+			q.Set(p, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	out := *u
+	out.RawQuery = q.Encode()
+	return out.String()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
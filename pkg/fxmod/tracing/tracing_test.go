@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func newTestTracing() (*Tracing, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return &Tracing{tracer: tp.Tracer(instrumentationName), propagator: propagation.TraceContext{}}, recorder
+}
+
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected string
+	}{
+		"api key":       {in: "https://chompthis.com/api/v2/food/branded/barcode.php?api_key=secret&code=1", expected: "https://chompthis.com/api/v2/food/branded/barcode.php?api_key=REDACTED&code=1"},
+		"empty api key": {in: "https://chompthis.com/search.php?api_key=&name=oats", expected: "https://chompthis.com/search.php?api_key=REDACTED&name=oats"},
+		"no secrets":    {in: "https://chompthis.com/search.php?name=oats&brand=x", expected: "https://chompthis.com/search.php?name=oats&brand=x"},
+		"no query":      {in: "https://chompthis.com/", expected: "https://chompthis.com/"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			u, err := url.Parse(tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, redactURL(u))
+			// The URL itself isn't modified.
+			require.Equal(t, tc.in, u.String())
+		})
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	tests := map[string]struct {
+		err       error
+		errorCode string
+		status    codes.Code
+	}{
+		"ok":            {status: codes.Unset},
+		"connect error": {err: connect.NewError(connect.CodeNotFound, errors.New("no foods found")), errorCode: "not_found", status: codes.Error},
+		"other error":   {err: errors.New("boom"), errorCode: "unknown", status: codes.Error},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tr, recorder := newTestTracing()
+			next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				require.True(t, trace.SpanContextFromContext(ctx).IsValid())
+				return nil, tc.err
+			})
+
+			_, err := tr.Interceptor().WrapUnary(next)(context.Background(), connect.NewRequest(&struct{}{}))
+			require.Equal(t, tc.err, err)
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+			require.Equal(t, tc.status, spans[0].Status().Code)
+			require.Equal(t, tc.errorCode, attributeValue(spans[0], "rpc.connect_rpc.error_code"))
+		})
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	tests := map[string]struct {
+		res    *http.Response
+		err    error
+		status codes.Code
+	}{
+		"ok":          {res: &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, status: codes.Unset},
+		"bad gateway": {res: &http.Response{StatusCode: http.StatusBadGateway, Body: http.NoBody}, status: codes.Error},
+		"no response": {err: errors.New("connection refused"), status: codes.Error},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tr, recorder := newTestTracing()
+			rt := tr.RoundTripper("chomp", roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				require.NotEmpty(t, req.Header.Get("traceparent"))
+				return tc.res, tc.err
+			}))
+
+			req, err := http.NewRequest(http.MethodGet, "https://chompthis.com/api/v2/food/branded/barcode.php?api_key=secret&code=1", nil)
+			require.NoError(t, err)
+			_, err = rt.RoundTrip(req)
+			require.Equal(t, tc.err, err)
+			// The caller's request isn't modified.
+			require.Empty(t, req.Header.Get("traceparent"))
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, "chomp GET", spans[0].Name())
+			require.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+			require.Equal(t, tc.status, spans[0].Status().Code)
+			require.NotContains(t, attributeValue(spans[0], "http.url"), "secret")
+		})
+	}
+}